@@ -0,0 +1,262 @@
+package growthbook
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// SetAttributes replaces the provider's persistent attributes, such as the
+// region or environment of a server process. They are merged beneath the
+// context of every evaluation and, unlike WithStaticHints, can be changed at
+// any time. It's safe to call concurrently with evaluations.
+//
+// Clones take a copy of the persistent attributes when they are created.
+func (p *Provider) SetAttributes(attrs map[string]interface{}) {
+	copied := make(gb.Attributes, len(attrs))
+	for k, v := range attrs {
+		copied[k] = v
+	}
+
+	p.attrsMutex.Lock()
+	defer p.attrsMutex.Unlock()
+	p.attributes = copied
+}
+
+// buildAttributes converts an evaluation context into the full set of
+// GrowthBook attributes of an evaluation. Static hints are applied first, then
+// the attributes of the Init context, the attributes a clone carries and the
+// persistent attributes, so the evaluation context takes precedence.
+func (p *Provider) buildAttributes(evalCtx openfeature.FlattenedContext) gb.Attributes {
+	attr := make(gb.Attributes, len(p.staticHints)+len(p.baseAttrs)+len(evalCtx))
+
+	for k, v := range p.staticHints {
+		attr[k] = v
+	}
+
+	p.attrsMutex.RLock()
+	for k, v := range p.initAttrs {
+		attr[k] = v
+	}
+	p.attrsMutex.RUnlock()
+
+	for k, v := range p.baseAttrs {
+		attr[k] = v
+	}
+
+	for k, v := range p.contextAttributes(evalCtx) {
+		attr[k] = v
+	}
+
+	return attr
+}
+
+// contextAttributes returns the persistent attributes merged with the
+// attributes of evalCtx, sanitized for GrowthBook
+func (p *Provider) contextAttributes(evalCtx openfeature.FlattenedContext) gb.Attributes {
+	return p.mergeAttributes(evalCtx, true)
+}
+
+// mergeAttributes is contextAttributes, filtering the attributes of evalCtx
+// with the allowlist set with WithAttributeAllowlist only if filtered is set
+func (p *Provider) mergeAttributes(evalCtx openfeature.FlattenedContext, filtered bool) gb.Attributes {
+	p.attrsMutex.RLock()
+	attr := make(gb.Attributes, len(p.attributes)+len(evalCtx))
+	for k, v := range p.attributes {
+		attr[k] = v
+	}
+	p.attrsMutex.RUnlock()
+
+	// Convert evalCtx to GrowthBook attributes
+	merged := p.mergeNestedContexts(evalCtx)
+	var dropped []string
+	for k, v := range merged {
+		if strings.HasPrefix(k, "$") {
+			if k = p.mapReservedKey(k); k == "" {
+				continue
+			}
+		}
+		if filtered && !p.allowsAttribute(k) {
+			dropped = append(dropped, k)
+			continue
+		}
+		attr[k] = v
+	}
+	if id, ok := targetingID(merged); ok && (!filtered || p.allowsAttribute("id")) {
+		attr["id"] = id
+	}
+	if len(dropped) > 0 {
+		p.log().Debug("Dropped attributes missing from the allowlist", "attributes", dropped)
+	}
+	for key, normalize := range p.normalizers {
+		if value, ok := attr[key].(string); ok {
+			p.safely("normalizer of attribute '"+key+"'", func() { attr[key] = normalize(value) })
+		}
+	}
+	coerceBoolAttributes(attr, p.boolAttrs)
+	if p.omitZeroAttrs {
+		omitZeroAttributes(attr)
+	}
+
+	return p.sanitizeAttributes(attr)
+}
+
+// mapReservedKey returns the attribute key for a "$"-prefixed key of an
+// evaluation context, which OpenFeature reserves (e.g. "$groups"), with the
+// mapping set with WithReservedKeyMapping. It returns "" to drop the key,
+// which is the default.
+func (p *Provider) mapReservedKey(key string) string {
+	if p.reservedKeys == nil {
+		return ""
+	}
+	mapped := ""
+	p.safely("reserved key mapping", func() { mapped = p.reservedKeys(key) })
+	return mapped
+}
+
+// allowsAttribute reports whether the evaluation context attribute key may be
+// passed to GrowthBook under the allowlist set with WithAttributeAllowlist
+func (p *Provider) allowsAttribute(key string) bool {
+	return p.attrAllowlist == nil || p.attrAllowlist[key]
+}
+
+// coerceBoolAttributes converts the "true"/"1" and "false"/"0" string values
+// of the given attributes to booleans
+func coerceBoolAttributes(attrs gb.Attributes, keys map[string]bool) {
+	for key := range keys {
+		switch attrs[key] {
+		case "true", "1":
+			attrs[key] = true
+		case "false", "0":
+			attrs[key] = false
+		}
+	}
+}
+
+// omitZeroAttributes deletes the attributes that are nil or hold the zero
+// value of a scalar type (empty string, 0, false). Empty maps and slices are
+// kept.
+func omitZeroAttributes(attrs gb.Attributes) {
+	for key, value := range attrs {
+		if value == nil {
+			delete(attrs, key)
+			continue
+		}
+		v := reflect.ValueOf(value)
+		switch v.Kind() {
+		case reflect.Bool, reflect.String,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			if v.IsZero() {
+				delete(attrs, key)
+			}
+		}
+	}
+}
+
+// targetingID returns the value of GrowthBook's "id" attribute for an
+// evaluation context. An explicit "id" takes precedence over the OpenFeature
+// targeting key, which is only used when it's a non-empty string.
+func targetingID(evalCtx map[string]interface{}) (interface{}, bool) {
+	if id, ok := evalCtx["id"]; ok {
+		return id, true
+	}
+	if key, ok := evalCtx[openfeature.TargetingKey].(string); ok && key != "" {
+		return key, true
+	}
+	return nil, false
+}
+
+// missingRequiredAttributes returns the attributes set with
+// WithRequiredAttributes that are missing from the merged attributes of evalCtx
+func (p *Provider) missingRequiredAttributes(evalCtx openfeature.FlattenedContext) []string {
+	if len(p.requiredAttrs) == 0 {
+		return nil
+	}
+	attrs := p.buildAttributes(evalCtx)
+
+	var missing []string
+	for _, key := range p.requiredAttrs {
+		if !hasAttributePath(attrs, strings.Split(key, ".")) {
+			missing = append(missing, key)
+		}
+	}
+	return missing
+}
+
+// AttributeSource provides attributes for an evaluation from its context,
+// e.g. from the HTTP request being served. See WithAttributeSources.
+type AttributeSource interface {
+	Attributes(ctx context.Context) (map[string]interface{}, error)
+}
+
+// resolveAttributes enriches evalCtx with the attributes of the sources set
+// with WithAttributeSources, then with those returned by the resolver set with
+// WithAttributeResolver, if any. The resolver gets the full attributes of the
+// evaluation. Only the attributes that aren't already set are added, so
+// explicit values take precedence. evalCtx isn't modified.
+func (p *Provider) resolveAttributes(ctx context.Context, evalCtx openfeature.FlattenedContext) openfeature.FlattenedContext {
+	evalCtx = p.sourceAttributes(ctx, evalCtx)
+	if p.attrResolver == nil {
+		return evalCtx
+	}
+	attrs := p.buildAttributes(evalCtx)
+	var resolved map[string]interface{}
+	if !p.safely("attribute resolver", func() { resolved = p.attrResolver(ctx, copyAttributes(attrs)) }) {
+		return evalCtx
+	}
+	return enrichContext(evalCtx, attrs, resolved)
+}
+
+// sourceAttributes enriches evalCtx with the attributes of the sources set
+// with WithAttributeSources, later sources overriding earlier ones. Sources
+// that fail are logged and skipped.
+func (p *Provider) sourceAttributes(ctx context.Context, evalCtx openfeature.FlattenedContext) openfeature.FlattenedContext {
+	if len(p.attrSources) == 0 {
+		return evalCtx
+	}
+	sourced := map[string]interface{}{}
+	for i, source := range p.attrSources {
+		var attrs map[string]interface{}
+		var err error
+		if !p.safely("attribute source", func() { attrs, err = source.Attributes(ctx) }) {
+			continue
+		}
+		if err != nil {
+			p.log().Warn("Skipping attribute source that failed", "source", i, "error", err)
+			continue
+		}
+		for k, v := range attrs {
+			sourced[k] = v
+		}
+	}
+	return enrichContext(evalCtx, p.buildAttributes(evalCtx), sourced)
+}
+
+// enrichContext returns a copy of evalCtx with the attributes of extra that
+// aren't set in attrs, the full attributes of evalCtx
+func enrichContext(evalCtx openfeature.FlattenedContext, attrs gb.Attributes, extra map[string]interface{}) openfeature.FlattenedContext {
+	enriched := make(openfeature.FlattenedContext, len(evalCtx)+len(extra))
+	for k, v := range evalCtx {
+		enriched[k] = v
+	}
+	for k, v := range extra {
+		if _, ok := attrs[k]; !ok {
+			enriched[k] = v
+		}
+	}
+	return enriched
+}
+
+// copyAttributes returns a shallow copy of attrs as a plain map
+func copyAttributes(attrs gb.Attributes) map[string]interface{} {
+	copied := make(map[string]interface{}, len(attrs))
+	for k, v := range attrs {
+		copied[k] = v
+	}
+	return copied
+}
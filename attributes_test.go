@@ -0,0 +1,406 @@
+package growthbook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestStaticHintsMatchCondition(t *testing.T) {
+	featuresJSON := `{
+		"eu-only": {
+			"defaultValue": false,
+			"rules": [{"condition": {"region": "eu-west-1"}, "force": true}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, false, WithStaticHints(map[string]interface{}{"region": "eu-west-1"}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.BooleanEvaluation(context.Background(), "eu-only", false, openfeature.FlattenedContext{"id": "user-1"})
+	if !result.Value {
+		t.Error("Expected static hint to satisfy the region condition")
+	}
+
+	// Evaluation context overrides the hint
+	result = provider.BooleanEvaluation(context.Background(), "eu-only", false, openfeature.FlattenedContext{"region": "us-east-1"})
+	if result.Value {
+		t.Error("Expected evaluation context to take precedence over static hints")
+	}
+}
+
+func TestSetAttributesAfterInit(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	result := provider.BooleanEvaluation(context.Background(), "rules-test", false, nil)
+	if result.Value {
+		t.Fatal("Expected rule not to match before attributes are set")
+	}
+
+	provider.SetAttributes(map[string]interface{}{"email": "user@growthbook.com"})
+	result = provider.BooleanEvaluation(context.Background(), "rules-test", false, nil)
+	if !result.Value {
+		t.Error("Expected persistent attributes to match the rule")
+	}
+
+	// Per-call attributes take precedence
+	result = provider.BooleanEvaluation(context.Background(), "rules-test", false, openfeature.FlattenedContext{"email": "foo@bar.com"})
+	if result.Value {
+		t.Error("Expected per-call attributes to override persistent attributes")
+	}
+
+	provider.SetAttributes(nil)
+	result = provider.BooleanEvaluation(context.Background(), "rules-test", false, nil)
+	if result.Value {
+		t.Error("Expected cleared attributes to stop matching")
+	}
+}
+
+func TestRequiredAttributes(t *testing.T) {
+	provider := setupTestProvider(WithRequiredAttributes([]string{"tenantId", "company.id"}))
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	result := provider.BooleanEvaluation(context.Background(), "bool-flag", false, openfeature.FlattenedContext{"company": map[string]interface{}{"name": "Acme"}})
+	if result.ResolutionDetail().ErrorCode != openfeature.TargetingKeyMissingCode {
+		t.Fatalf("Expected TARGETING_KEY_MISSING, got %q", result.ResolutionDetail().ErrorCode)
+	}
+	message := result.ResolutionDetail().ErrorMessage
+	if !strings.Contains(message, "tenantId") || !strings.Contains(message, "company.id") {
+		t.Errorf("Expected the error to name the missing attributes, got %q", message)
+	}
+	if result.Value {
+		t.Error("Expected the default value when required attributes are missing")
+	}
+
+	// Persistent attributes count towards the merged context
+	provider.SetAttributes(map[string]interface{}{"tenantId": "tenant-1"})
+	result = provider.BooleanEvaluation(context.Background(), "bool-flag", false, openfeature.FlattenedContext{"company": map[string]interface{}{"id": "acme"}})
+	if !result.Value || result.ResolutionDetail().ErrorCode != "" {
+		t.Errorf("Expected evaluation to proceed with all required attributes, got %v (%s)", result.Value, result.ResolutionDetail().ErrorCode)
+	}
+}
+
+func TestInitAttributes(t *testing.T) {
+	initCtx := openfeature.NewEvaluationContext("test-user", map[string]interface{}{
+		"email": "user@growthbook.com",
+	})
+
+	tests := []struct {
+		name     string
+		options  []interface{}
+		expected bool
+	}{
+		{"disabled by default", nil, false},
+		{"disabled", []interface{}{WithInitAttributes(false)}, false},
+		{"enabled", []interface{}{WithInitAttributes(true)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := setupTestProvider(tt.options...)
+			_ = provider.Init(initCtx)
+
+			result := provider.BooleanEvaluation(context.Background(), "rules-test", false, nil)
+			if result.Value != tt.expected {
+				t.Errorf("Expected rules-test to be %v, got %v", tt.expected, result.Value)
+			}
+
+			// The client itself never picks up the Init attributes
+			if provider.gbClient.EvalFeature(context.Background(), "rules-test").On {
+				t.Error("Expected the GrowthBook client's attributes to be unchanged by Init")
+			}
+		})
+	}
+}
+
+func TestInitAttributesBeneathEvaluationContext(t *testing.T) {
+	provider := setupTestProvider(WithInitAttributes(true))
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", map[string]interface{}{
+		"email": "user@growthbook.com",
+	}))
+
+	result := provider.BooleanEvaluation(context.Background(), "rules-test", false, openfeature.FlattenedContext{"email": "other@example.com"})
+	if result.Value {
+		t.Error("Expected the evaluation context to override the Init attributes")
+	}
+}
+
+func TestTargetingKeyMapsToID(t *testing.T) {
+	provider := setupTestProvider()
+
+	tests := []struct {
+		name     string
+		evalCtx  openfeature.FlattenedContext
+		expected interface{}
+	}{
+		{"targeting key only", openfeature.FlattenedContext{"targetingKey": "key-user"}, "key-user"},
+		{"explicit id wins", openfeature.FlattenedContext{"targetingKey": "key-user", "id": "id-user"}, "id-user"},
+		{"empty targeting key", openfeature.FlattenedContext{"targetingKey": ""}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := provider.buildAttributes(tt.evalCtx)
+			if attrs["id"] != tt.expected {
+				t.Errorf("Expected id %v, got %v", tt.expected, attrs["id"])
+			}
+		})
+	}
+}
+
+func TestTargetingKeyUsedForTargeting(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"vip": {"defaultValue": false, "rules": [{"condition": {"id": "vip-user"}, "force": true}]}
+	}`))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.BooleanEvaluation(context.Background(), "vip", false, openfeature.FlattenedContext{"targetingKey": "vip-user"})
+	if !result.Value {
+		t.Error("Expected the targeting key to match the id condition")
+	}
+	result = provider.BooleanEvaluation(context.Background(), "vip", false, openfeature.FlattenedContext{"targetingKey": "vip-user", "id": "other-user"})
+	if result.Value {
+		t.Error("Expected the explicit id to take precedence over the targeting key")
+	}
+}
+
+func TestOmitZeroAttributes(t *testing.T) {
+	featuresJSON := `{
+		"has-company": {"defaultValue": false, "rules": [{"condition": {"company": {"$exists": true}}, "force": true}]}
+	}`
+	evalCtx := openfeature.FlattenedContext{"company": "", "seats": 0, "trial": false}
+
+	tests := []struct {
+		name     string
+		enabled  bool
+		expected bool
+	}{
+		{"disabled", false, true},
+		{"enabled", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+			provider := NewProvider(gbClient, false, WithOmitZeroAttributes(tt.enabled))
+			_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+			result := provider.BooleanEvaluation(context.Background(), "has-company", false, evalCtx)
+			if result.Value != tt.expected {
+				t.Errorf("Expected has-company to be %v, got %v", tt.expected, result.Value)
+			}
+
+			attrs := provider.buildAttributes(evalCtx)
+			for _, key := range []string{"company", "seats", "trial"} {
+				if _, ok := attrs[key]; ok == tt.enabled {
+					t.Errorf("Expected %s to be present=%v, got %v", key, !tt.enabled, ok)
+				}
+			}
+		})
+	}
+}
+
+func TestAttributeResolverEnrichesAttributes(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"sso": {"defaultValue": false, "rules": [{"condition": {"orgPlan": "enterprise"}, "force": true}]}
+	}`))
+	orgPlans := map[string]string{"team-1": "enterprise", "team-2": "free"}
+	provider := NewProvider(gbClient, false, WithAttributeResolver(func(ctx context.Context, attrs map[string]interface{}) map[string]interface{} {
+		teamID, _ := attrs["teamId"].(string)
+		return map[string]interface{}{"orgPlan": orgPlans[teamID], "teamId": "overridden"}
+	}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	evalCtx := openfeature.FlattenedContext{"teamId": "team-1"}
+	if result := provider.BooleanEvaluation(context.Background(), "sso", false, evalCtx); !result.Value {
+		t.Errorf("Expected the resolved org plan to match the condition, got %v (%s)", result.Value, result.Reason)
+	}
+	if result := provider.BooleanEvaluation(context.Background(), "sso", false, openfeature.FlattenedContext{"teamId": "team-2"}); result.Value {
+		t.Error("Expected a free org not to match the condition")
+	}
+
+	// Explicit attributes take precedence over resolved ones
+	explicit := openfeature.FlattenedContext{"teamId": "team-1", "orgPlan": "free"}
+	if result := provider.BooleanEvaluation(context.Background(), "sso", false, explicit); result.Value {
+		t.Error("Expected the explicit org plan to win over the resolved one")
+	}
+	if evalCtx["orgPlan"] != nil {
+		t.Errorf("Expected the evaluation context not to be modified, got %v", evalCtx)
+	}
+}
+
+func TestAttributeAllowlistDropsOtherAttributes(t *testing.T) {
+	var logs bytes.Buffer
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"beta": {"defaultValue": false, "rules": [{"condition": {"email": {"$regex": "@example.com$"}}, "force": true}]},
+		"nz": {"defaultValue": false, "rules": [{"condition": {"country": "NZ"}, "force": true}]}
+	}`))
+	provider := NewProvider(gbClient, false,
+		WithAttributeAllowlist([]string{"country", "id"}),
+		WithLogger(slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	evalCtx := openfeature.FlattenedContext{"targetingKey": "user-1", "email": "jo@example.com", "country": "NZ"}
+	if result := provider.BooleanEvaluation(context.Background(), "beta", false, evalCtx); result.Value {
+		t.Error("Expected the email attribute not to reach GrowthBook")
+	}
+	if result := provider.BooleanEvaluation(context.Background(), "nz", false, evalCtx); !result.Value {
+		t.Error("Expected the allowlisted country attribute to match")
+	}
+
+	attrs := provider.buildAttributes(evalCtx)
+	if _, ok := attrs["email"]; ok {
+		t.Errorf("Expected email to be dropped, got %v", attrs)
+	}
+	if _, ok := attrs["targetingKey"]; ok {
+		t.Errorf("Expected targetingKey to be dropped, got %v", attrs)
+	}
+	if attrs["id"] != "user-1" {
+		t.Errorf("Expected the targeting key as the allowlisted id, got %v", attrs)
+	}
+	if !strings.Contains(logs.String(), "Dropped attributes missing from the allowlist") {
+		t.Errorf("Expected the dropped attributes to be logged, got %q", logs.String())
+	}
+}
+
+func TestAttributeNormalizerLowercasesEmail(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"beta": {"defaultValue": false, "rules": [{"condition": {"email": "user@example.com"}, "force": true}]}
+	}`))
+	provider := NewProvider(gbClient, false, WithAttributeNormalizer([]string{"email"}, strings.ToLower))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	evalCtx := openfeature.FlattenedContext{"email": "User@Example.com", "name": "Jo"}
+	if result := provider.BooleanEvaluation(context.Background(), "beta", false, evalCtx); !result.Value {
+		t.Error("Expected the lowercased email to match the condition")
+	}
+	attrs := provider.buildAttributes(evalCtx)
+	if attrs["name"] != "Jo" {
+		t.Errorf("Expected other attributes to be left alone, got %v", attrs["name"])
+	}
+
+	// Values that aren't strings aren't normalized
+	attrs = provider.buildAttributes(openfeature.FlattenedContext{"email": 42})
+	if attrs["email"] != 42 {
+		t.Errorf("Expected a non-string email to be kept, got %v", attrs["email"])
+	}
+}
+
+func TestBooleanAttributeCoercion(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"beta": {"defaultValue": false, "rules": [{"condition": {"betaTester": {"$eq": true}}, "force": true}]}
+	}`))
+	provider := NewProvider(gbClient, false, WithBooleanAttributeCoercion([]string{"betaTester"}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	evalCtx := openfeature.FlattenedContext{"betaTester": "true"}
+	if result := provider.BooleanEvaluation(context.Background(), "beta", false, evalCtx); !result.Value {
+		t.Error("Expected the coerced attribute to match the boolean condition")
+	}
+
+	for value, expected := range map[string]interface{}{"1": true, "false": false, "0": false, "yes": "yes"} {
+		attrs := provider.buildAttributes(openfeature.FlattenedContext{"betaTester": value, "plan": "1"})
+		if attrs["betaTester"] != expected {
+			t.Errorf("Expected %q to become %v, got %v", value, expected, attrs["betaTester"])
+		}
+		if attrs["plan"] != "1" {
+			t.Errorf("Expected other attributes to be left alone, got %v", attrs["plan"])
+		}
+	}
+
+	// Without coercion the string doesn't match
+	plain := NewProvider(gbClient, false)
+	_ = plain.Init(openfeature.NewEvaluationContext("", nil))
+	if result := plain.BooleanEvaluation(context.Background(), "beta", false, evalCtx); result.Value {
+		t.Error("Expected the string attribute not to match without coercion")
+	}
+}
+
+func TestReservedKeysDroppedByDefault(t *testing.T) {
+	provider := setupTestProvider()
+	evalCtx := openfeature.FlattenedContext{"$groups": []interface{}{"beta"}, "$flagd": map[string]interface{}{"flagKey": "x"}, "country": "US"}
+
+	attrs := provider.buildAttributes(evalCtx)
+	for _, key := range []string{"$groups", "$flagd"} {
+		if _, ok := attrs[key]; ok {
+			t.Errorf("Expected reserved key %s to be dropped, got %v", key, attrs)
+		}
+	}
+	if attrs["country"] != "US" {
+		t.Errorf("Expected other attributes to be kept, got %v", attrs)
+	}
+}
+
+func TestReservedKeyMapping(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"beta": {"defaultValue": false, "rules": [{"condition": {"groups": {"$elemMatch": {"$eq": "beta"}}}, "force": true}]}
+	}`))
+	provider := NewProvider(gbClient, false, WithReservedKeyMapping(func(key string) string {
+		if key == "$groups" {
+			return "groups"
+		}
+		return ""
+	}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	evalCtx := openfeature.FlattenedContext{"$groups": []interface{}{"beta"}, "$flagd": "x"}
+	if result := provider.BooleanEvaluation(context.Background(), "beta", false, evalCtx); !result.Value {
+		t.Errorf("Expected the remapped groups to match, got %v (%v)", result.Value, result.Error())
+	}
+	attrs := provider.buildAttributes(evalCtx)
+	if _, ok := attrs["$flagd"]; ok {
+		t.Errorf("Expected unmapped reserved keys to be dropped, got %v", attrs)
+	}
+}
+
+// attributeSourceFunc adapts a function to AttributeSource
+type attributeSourceFunc func(ctx context.Context) (map[string]interface{}, error)
+
+func (f attributeSourceFunc) Attributes(ctx context.Context) (map[string]interface{}, error) {
+	return f(ctx)
+}
+
+func TestAttributeSourcesMergeInOrder(t *testing.T) {
+	var logs bytes.Buffer
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"gold-us": {"defaultValue": false, "rules": [{"condition": {"tier": "gold", "country": "US"}, "force": true}]}
+	}`))
+	geo := attributeSourceFunc(func(ctx context.Context) (map[string]interface{}, error) {
+		return map[string]interface{}{"country": "US", "tier": "free"}, nil
+	})
+	broken := attributeSourceFunc(func(ctx context.Context) (map[string]interface{}, error) {
+		return map[string]interface{}{"country": "FR"}, errors.New("session store unavailable")
+	})
+	session := attributeSourceFunc(func(ctx context.Context) (map[string]interface{}, error) {
+		return map[string]interface{}{"tier": "gold"}, nil
+	})
+	provider := NewProvider(gbClient, false,
+		WithAttributeSources(geo, broken, session),
+		WithLogger(slog.New(slog.NewTextHandler(&logs, nil))),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	// The later session source overrides the tier, and the failing source is skipped
+	resolved := provider.resolveAttributes(context.Background(), openfeature.FlattenedContext{})
+	if resolved["country"] != "US" || resolved["tier"] != "gold" {
+		t.Errorf("Expected country US and tier gold, got %v", resolved)
+	}
+	if result := provider.BooleanEvaluation(context.Background(), "gold-us", false, nil); !result.Value {
+		t.Errorf("Expected the sourced attributes to match the condition, got %v (%s)", result.Value, result.Reason)
+	}
+	if !strings.Contains(logs.String(), "session store unavailable") {
+		t.Errorf("Expected the failing source to be logged, got %q", logs.String())
+	}
+
+	// Explicit attributes take precedence over sourced ones
+	explicit := openfeature.FlattenedContext{"tier": "free"}
+	if result := provider.BooleanEvaluation(context.Background(), "gold-us", false, explicit); result.Value {
+		t.Error("Expected the explicit tier to win over the sourced one")
+	}
+}
@@ -0,0 +1,180 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// auditLine is the JSON line written to the audit log for an evaluation
+type auditLine struct {
+	Timestamp             time.Time             `json:"timestamp"`
+	Flag                  string                `json:"flag"`
+	Value                 interface{}           `json:"value"`
+	Reason                openfeature.Reason    `json:"reason"`
+	Variant               string                `json:"variant,omitempty"`
+	ErrorCode             openfeature.ErrorCode `json:"errorCode,omitempty"`
+	AttributesFingerprint string                `json:"attributesFingerprint"`
+	Domain                string                `json:"domain,omitempty"`
+}
+
+// auditLog serializes audit lines to a writer, either synchronously or
+// through a buffered channel drained by a goroutine
+type auditLog struct {
+	log func() *slog.Logger
+
+	mu sync.Mutex // Serializes writes to w
+	w  io.Writer
+
+	queueMutex sync.RWMutex   // Guards sending to lines against closing it
+	lines      chan auditItem // nil for a synchronous log
+	closed     bool
+	drained    chan struct{}
+}
+
+// auditItem is a queued audit line, or a flush marker closed once the lines
+// queued before it are written
+type auditItem struct {
+	line    []byte
+	flushed chan struct{}
+}
+
+func newAuditLog(w io.Writer, bufferSize int, log func() *slog.Logger) *auditLog {
+	a := &auditLog{w: w, log: log}
+	if bufferSize > 0 {
+		a.lines = make(chan auditItem, bufferSize)
+		a.drained = make(chan struct{})
+		go a.drain()
+	}
+	return a
+}
+
+// write writes line, or queues it for the writer goroutine. Queuing blocks
+// while the buffer is full so no line is lost.
+func (a *auditLog) write(line []byte) {
+	if a.lines != nil {
+		a.queueMutex.RLock()
+		defer a.queueMutex.RUnlock()
+		if !a.closed {
+			a.lines <- auditItem{line: line}
+			return
+		}
+	}
+	a.writeLine(line)
+}
+
+// writeLine writes line to the writer, reporting errors through the logger
+func (a *auditLog) writeLine(line []byte) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := a.w.Write(line); err != nil {
+		a.log().Error("Failed to write audit log line", "error", err)
+	}
+}
+
+// drain writes queued lines until the log is closed
+func (a *auditLog) drain() {
+	defer close(a.drained)
+	for item := range a.lines {
+		if item.flushed != nil {
+			close(item.flushed)
+			continue
+		}
+		a.writeLine(item.line)
+	}
+}
+
+// flush waits until the lines queued so far are written, or ctx is done
+func (a *auditLog) flush(ctx context.Context) error {
+	if a.lines == nil {
+		return nil
+	}
+	a.queueMutex.RLock()
+	if a.closed {
+		a.queueMutex.RUnlock()
+		return a.wait(ctx)
+	}
+	flushed := make(chan struct{})
+	select {
+	case a.lines <- auditItem{flushed: flushed}:
+	case <-ctx.Done():
+		a.queueMutex.RUnlock()
+		return ctx.Err()
+	}
+	a.queueMutex.RUnlock()
+
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// close stops the writer goroutine once the queued lines are written, waiting
+// for it until ctx is done. Lines written afterwards are written
+// synchronously.
+func (a *auditLog) close(ctx context.Context) error {
+	if a.lines == nil {
+		return nil
+	}
+	a.queueMutex.Lock()
+	if !a.closed {
+		a.closed = true
+		close(a.lines)
+	}
+	a.queueMutex.Unlock()
+	return a.wait(ctx)
+}
+
+// wait waits for the writer goroutine to exit after the log is closed
+func (a *auditLog) wait(ctx context.Context) error {
+	select {
+	case <-a.drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// audit writes an evaluation to the audit log, if it's enabled
+func (p *Provider) audit(flag string, value interface{}, evalCtx openfeature.FlattenedContext, detail openfeature.ProviderResolutionDetail) {
+	if p.auditLog == nil {
+		return
+	}
+	if p.oversized(value) {
+		p.log().Debug("Skipped auditing an oversized object", "flag", flag, "maxObjectSize", p.maxObjectSize)
+		return
+	}
+	line, err := json.Marshal(auditLine{
+		Timestamp:             p.now(),
+		Flag:                  flag,
+		Value:                 value,
+		Reason:                detail.Reason,
+		Variant:               detail.Variant,
+		ErrorCode:             detail.ResolutionDetail().ErrorCode,
+		AttributesFingerprint: fingerprint(p.buildAttributes(evalCtx)),
+		Domain:                p.domain,
+	})
+	if err != nil {
+		p.log().Error("Failed to encode audit log line", "flag", flag, "error", err)
+		return
+	}
+	p.auditLog.write(append(line, '\n'))
+}
+
+// Flush waits until the events buffered by the provider are delivered, which
+// are the lines queued for the audit log set with WithAsyncAuditLog, or until
+// ctx is done. Exposures are reported to the tracking callback as they happen,
+// so they are never buffered.
+func (p *Provider) Flush(ctx context.Context) error {
+	if p.auditLog == nil {
+		return nil
+	}
+	return p.auditLog.flush(ctx)
+}
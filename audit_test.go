@@ -0,0 +1,174 @@
+package growthbook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// auditLines decodes the JSON lines written to buf
+func auditLines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("Expected a JSON line, got %q", scanner.Text())
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func TestAuditLogWritesJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	clock := newFakeClock()
+	provider := setupTestProvider(WithAuditLog(&buf), WithClock(clock.Now))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	provider.BooleanEvaluation(context.Background(), "rules-test", false, openfeature.FlattenedContext{"email": "user@growthbook.com"})
+	provider.StringEvaluation(context.Background(), "missing-flag", "default", nil)
+
+	lines := auditLines(t, &buf)
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 audit lines, got %d", len(lines))
+	}
+
+	first := lines[0]
+	if first["flag"] != "rules-test" || first["value"] != true || first["reason"] != string(openfeature.TargetingMatchReason) || first["variant"] != "rule_id" {
+		t.Errorf("Unexpected audit line for rules-test: %v", first)
+	}
+	if first["timestamp"] != clock.Now().Format(time.RFC3339Nano) {
+		t.Errorf("Expected timestamp %s, got %v", clock.Now().Format(time.RFC3339Nano), first["timestamp"])
+	}
+	if fp, _ := first["attributesFingerprint"].(string); fp == "" || strings.Contains(fp, "growthbook.com") {
+		t.Errorf("Expected an opaque attributes fingerprint, got %v", first["attributesFingerprint"])
+	}
+
+	second := lines[1]
+	if second["flag"] != "missing-flag" || second["value"] != "default" || second["errorCode"] != string(openfeature.FlagNotFoundCode) {
+		t.Errorf("Unexpected audit line for missing-flag: %v", second)
+	}
+}
+
+func TestAsyncAuditLogFlushedOnShutdown(t *testing.T) {
+	var buf bytes.Buffer
+	provider := setupTestProvider(WithAsyncAuditLog(&buf, 4))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	for i := 0; i < 10; i++ {
+		provider.IntEvaluation(context.Background(), "int-flag", 0, nil)
+	}
+	provider.Shutdown()
+
+	lines := auditLines(t, &buf)
+	if len(lines) != 10 {
+		t.Fatalf("Expected all 10 audit lines after Shutdown, got %d", len(lines))
+	}
+	for _, line := range lines {
+		if line["flag"] != "int-flag" || line["value"] != float64(42) {
+			t.Errorf("Unexpected audit line: %v", line)
+		}
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestAuditLogWriteErrorsAreLogged(t *testing.T) {
+	var logs bytes.Buffer
+	provider := setupTestProvider(
+		WithAuditLog(failingWriter{}),
+		WithLogger(slog.New(slog.NewTextHandler(&logs, nil))),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+	if !strings.Contains(logs.String(), "disk full") {
+		t.Errorf("Expected the write error to be logged, got %q", logs.String())
+	}
+}
+
+// slowWriter counts the lines written to it. Writes block until release is
+// closed.
+type slowWriter struct {
+	mu      sync.Mutex
+	lines   int
+	release chan struct{}
+}
+
+func (w *slowWriter) Write(p []byte) (int, error) {
+	<-w.release
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lines++
+	return len(p), nil
+}
+
+func (w *slowWriter) count() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.lines
+}
+
+func TestFlushDeliversQueuedAuditLines(t *testing.T) {
+	w := &slowWriter{release: make(chan struct{})}
+	provider := setupTestProvider(WithAsyncAuditLog(w, 16))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	for i := 0; i < 10; i++ {
+		provider.IntEvaluation(context.Background(), "int-flag", 0, nil)
+	}
+
+	// The writer is stuck, so a bounded flush gives up
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := provider.Flush(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the flush to time out, got %v", err)
+	}
+
+	close(w.release)
+	if err := provider.Flush(context.Background()); err != nil {
+		t.Fatalf("Expected the flush to succeed, got %v", err)
+	}
+	if got := w.count(); got != 10 {
+		t.Errorf("Expected all 10 audit lines after Flush, got %d", got)
+	}
+
+	// The log keeps working after a flush
+	provider.IntEvaluation(context.Background(), "int-flag", 0, nil)
+	if err := provider.ShutdownWithContext(context.Background()); err != nil {
+		t.Errorf("Expected Shutdown to succeed, got %v", err)
+	}
+	if got := w.count(); got != 11 {
+		t.Errorf("Expected the line written after Flush to be delivered by Shutdown, got %d", got)
+	}
+}
+
+func TestShutdownWithContextStopsWaiting(t *testing.T) {
+	w := &slowWriter{release: make(chan struct{})}
+	defer close(w.release)
+	provider := setupTestProvider(WithAsyncAuditLog(w, 4))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := provider.ShutdownWithContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the shutdown to stop waiting at the deadline, got %v", err)
+	}
+	if provider.Status() != openfeature.NotReadyState {
+		t.Errorf("Expected NotReadyState after shutdown, got %v", provider.Status())
+	}
+}
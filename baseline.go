@@ -0,0 +1,46 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// EvaluateWithBaseline resolves flag and reports whether its value differs
+// from baseline, e.g. the control value of a rollout, for canary tooling.
+// Values are compared by their JSON representation, so objects are compared
+// deeply and numbers compare equal regardless of their Go type (42 and 42.0).
+// If the flag can't be resolved, baseline is returned as its value and
+// doesn't differ.
+func (p *Provider) EvaluateWithBaseline(ctx context.Context, flag string, baseline interface{}, evalCtx openfeature.FlattenedContext) (interface{}, bool, openfeature.ProviderResolutionDetail) {
+	result := p.ObjectEvaluation(ctx, flag, baseline, evalCtx)
+	if result.Error() != nil {
+		return baseline, false, result.ProviderResolutionDetail
+	}
+	return result.Value, !sameJSONValue(result.Value, baseline), result.ProviderResolutionDetail
+}
+
+// sameJSONValue reports whether a and b encode to the same JSON value
+func sameJSONValue(a, b interface{}) bool {
+	decodedA, okA := jsonRoundTrip(a)
+	decodedB, okB := jsonRoundTrip(b)
+	if !okA || !okB {
+		return reflect.DeepEqual(a, b)
+	}
+	return reflect.DeepEqual(decodedA, decodedB)
+}
+
+// jsonRoundTrip encodes v to JSON and decodes it back into generic values
+func jsonRoundTrip(v interface{}) (interface{}, bool) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, false
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, false
+	}
+	return decoded, true
+}
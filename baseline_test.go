@@ -0,0 +1,60 @@
+package growthbook
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestEvaluateWithBaseline(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"checkout": {
+			"defaultValue": {"layout": "classic", "steps": 3},
+			"rules": [{"key": "checkout-exp", "variations": [
+				{"layout": "classic", "steps": 3},
+				{"layout": "one-page", "steps": 1}
+			], "coverage": 1}]
+		}
+	}`))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	// Steps is an int here and a float64 in the flag, which still compare equal
+	baseline := map[string]interface{}{"layout": "classic", "steps": 3}
+	matching, differing := 0, 0
+	for i := 0; i < 50; i++ {
+		evalCtx := openfeature.FlattenedContext{"id": fmt.Sprintf("user-%d", i)}
+		value, differs, detail := provider.EvaluateWithBaseline(context.Background(), "checkout", baseline, evalCtx)
+		if detail.Error() != nil {
+			t.Fatalf("Expected the flag to resolve, got %v", detail.Error())
+		}
+		layout := value.(map[string]interface{})["layout"]
+		if differs != (layout == "one-page") {
+			t.Errorf("Expected differs to be %v for layout %v", layout == "one-page", layout)
+		}
+		if differs {
+			differing++
+		} else {
+			matching++
+		}
+	}
+	if matching == 0 || differing == 0 {
+		t.Errorf("Expected some users to match and some to differ, got %d matching and %d differing", matching, differing)
+	}
+}
+
+func TestEvaluateWithBaselineMissingFlag(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	value, differs, detail := provider.EvaluateWithBaseline(context.Background(), "missing-flag", "baseline", nil)
+	if value != "baseline" || differs {
+		t.Errorf("Expected the baseline not to differ for a missing flag, got %v %v", value, differs)
+	}
+	if detail.ResolutionDetail().ErrorCode != openfeature.FlagNotFoundCode {
+		t.Errorf("Expected FLAG_NOT_FOUND, got %s", detail.ResolutionDetail().ErrorCode)
+	}
+}
@@ -0,0 +1,55 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// bootstrapFlag is the entry of a flag in a bootstrap payload
+type bootstrapFlag struct {
+	Value     interface{}           `json:"value"`
+	Reason    openfeature.Reason    `json:"reason"`
+	Variant   string                `json:"variant,omitempty"`
+	ErrorCode openfeature.ErrorCode `json:"errorCode,omitempty"`
+}
+
+// BootstrapPayload evaluates every loaded flag for evalCtx and returns the
+// results as JSON, keyed by flag, with each flag's value, reason and variant:
+//
+//	{"checkout": {"value": "treatment", "reason": "TARGETING_MATCH", "variant": "1"}}
+//
+// It's meant for server-side rendering, where the payload hydrates the
+// frontend so flags don't flip once the page loads. Exposures to experiments
+// are reported through the tracking callback as the flags are evaluated.
+//
+// Values are resolved like ObjectEvaluation's, so value transforms,
+// WithTreatEmptyObjectAsDefault and WithRejectOversizedObjects apply. A flag
+// rejected as oversized is included with a null value and its error code.
+func (p *Provider) BootstrapPayload(ctx context.Context, evalCtx openfeature.FlattenedContext) ([]byte, error) {
+	if !p.canEvaluate() {
+		return nil, fmt.Errorf("%w: cannot build bootstrap payload", ErrProviderNotReady)
+	}
+
+	evalCtx = p.resolveAttributes(ctx, evalCtx)
+	payload := make(map[string]bootstrapFlag)
+	for flag := range p.gbClient.Features() {
+		value, detail, _ := resolveTypedValue(p, ctx, flag, nil, evalCtx, toObject, "an object")
+		if code := detail.ResolutionDetail().ErrorCode; code == openfeature.ParseErrorCode {
+			payload[flag] = bootstrapFlag{Reason: detail.Reason, ErrorCode: code}
+			continue
+		}
+		if err := ResolutionErr(detail); err != nil {
+			return nil, fmt.Errorf("failed to evaluate flag '%s': %w", flag, err)
+		}
+		// The value is nil if the flag is disabled by the kill switch
+		payload[flag] = bootstrapFlag{
+			Value:   value,
+			Reason:  detail.Reason,
+			Variant: detail.Variant,
+		}
+	}
+	return json.Marshal(payload)
+}
@@ -0,0 +1,99 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestBootstrapPayload(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	raw, err := provider.BootstrapPayload(context.Background(), openfeature.FlattenedContext{"email": "user@growthbook.com"})
+	if err != nil {
+		t.Fatalf("BootstrapPayload failed: %v", err)
+	}
+
+	var payload map[string]struct {
+		Value   interface{} `json:"value"`
+		Reason  string      `json:"reason"`
+		Variant string      `json:"variant"`
+	}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		t.Fatalf("Expected a JSON payload, got %s", raw)
+	}
+
+	expected := map[string]interface{}{
+		"bool-flag":   true,
+		"string-flag": "default-string",
+		"number-flag": 42.5,
+		"int-flag":    float64(42),
+		"rules-test":  true,
+	}
+	if len(payload) != len(expected)+1 {
+		t.Errorf("Expected every flag in the payload, got %s", raw)
+	}
+	for flag, value := range expected {
+		if payload[flag].Value != value {
+			t.Errorf("Expected %s to be %v, got %v", flag, value, payload[flag].Value)
+		}
+	}
+	if object, ok := payload["object-flag"].Value.(map[string]interface{}); !ok || object["key"] != "value" {
+		t.Errorf("Expected object-flag's object, got %v", payload["object-flag"].Value)
+	}
+
+	// The rule matched the given attributes
+	if payload["rules-test"].Reason != string(openfeature.TargetingMatchReason) || payload["rules-test"].Variant != "rule_id" {
+		t.Errorf("Expected rules-test to match rule_id, got %+v", payload["rules-test"])
+	}
+	if payload["bool-flag"].Reason != string(openfeature.DefaultReason) {
+		t.Errorf("Expected bool-flag's DEFAULT reason, got %s", payload["bool-flag"].Reason)
+	}
+}
+
+func TestBootstrapPayloadNotReady(t *testing.T) {
+	provider := setupTestProvider()
+
+	if _, err := provider.BootstrapPayload(context.Background(), nil); !errors.Is(err, ErrProviderNotReady) {
+		t.Errorf("Expected ErrProviderNotReady, got %v", err)
+	}
+}
+
+func TestBootstrapPayloadConvertsLikeObjectEvaluation(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"page-size": {"defaultValue": 500},
+		"empty-config": {"defaultValue": {}},
+		"big-config": {"defaultValue": {"items": ["a", "b", "c", "d", "e", "f", "g", "h"]}}
+	}`))
+	provider := NewProvider(gbClient, false,
+		WithValueTransform("page-size", func(value interface{}) interface{} { return math.Min(value.(float64), 100) }),
+		WithTreatEmptyObjectAsDefault(true),
+		WithMaxObjectSize(32),
+		WithRejectOversizedObjects(true),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	raw, err := provider.BootstrapPayload(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("BootstrapPayload failed: %v", err)
+	}
+	var payload map[string]map[string]interface{}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		t.Fatalf("Expected a JSON payload, got %s", raw)
+	}
+	if payload["page-size"]["value"] != float64(100) {
+		t.Errorf("Expected the transformed page size, got %v", payload["page-size"])
+	}
+	if empty := payload["empty-config"]; empty["value"] != nil || empty["reason"] != string(openfeature.DefaultReason) {
+		t.Errorf("Expected the empty object to be served as the default, got %v", empty)
+	}
+	if big := payload["big-config"]; big["value"] != nil || big["errorCode"] != string(openfeature.ParseErrorCode) {
+		t.Errorf("Expected the oversized object to be rejected, got %v", big)
+	}
+}
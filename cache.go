@@ -0,0 +1,113 @@
+package growthbook
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// cacheKey identifies a cached evaluation
+type cacheKey struct {
+	flag  string
+	kind  string // The kind of value requested, as flags convert differently per type
+	attrs string // Fingerprint of the evaluation's attributes
+}
+
+// cacheEntry is a cached evaluation result
+type cacheEntry struct {
+	value   interface{}
+	detail  openfeature.ProviderResolutionDetail
+	expires time.Time
+}
+
+// evaluationCache caches evaluation results for a TTL. It's emptied whenever
+// the client's features change.
+type evaluationCache struct {
+	ttl time.Duration
+
+	mu       sync.Mutex
+	entries  map[cacheKey]cacheEntry
+	features uintptr // Identity of the feature map the entries were evaluated against
+}
+
+func newEvaluationCache(ttl time.Duration) *evaluationCache {
+	return &evaluationCache{ttl: ttl, entries: make(map[cacheKey]cacheEntry)}
+}
+
+// get returns the unexpired entry for key, if features are still those the
+// cache was filled from
+func (c *evaluationCache) get(key cacheKey, now time.Time, features gb.FeatureMap) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidate(features)
+	entry, ok := c.entries[key]
+	if !ok || !now.Before(entry.expires) {
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
+
+// put caches an evaluation of key against features
+func (c *evaluationCache) put(key cacheKey, value interface{}, detail openfeature.ProviderResolutionDetail, now time.Time, features gb.FeatureMap) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidate(features)
+	c.entries[key] = cacheEntry{value: value, detail: detail, expires: now.Add(c.ttl)}
+}
+
+// clear empties the cache, e.g. when an override changes results without
+// the features changing. It's a no-op on a nil cache.
+func (c *evaluationCache) clear() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+}
+
+// invalidate empties the cache if features aren't those it was filled from.
+// The client replaces its feature map on every update, so comparing the maps'
+// identity is enough. It must be called with mu held.
+func (c *evaluationCache) invalidate(features gb.FeatureMap) {
+	current := reflect.ValueOf(features).Pointer()
+	if current != c.features {
+		clear(c.entries)
+		c.features = current
+	}
+}
+
+// cacheKey returns the cache key of an evaluation, and whether it can be
+// served from the cache at all. Evaluations aren't cached while the provider
+// isn't ready, when the context forces variations, carries a dev mode URL or
+// an EvaluateAt time, or when their attributes can't be fingerprinted.
+func (p *Provider) cacheKey(ctx context.Context, flag string, kind string, evalCtx openfeature.FlattenedContext) (cacheKey, bool) {
+	if p.cache == nil || !p.canEvaluate() {
+		return cacheKey{}, false
+	}
+	if ctx.Value(forcedVariationsKey{}) != nil || ctx.Value(devURLKey{}) != nil || ctx.Value(evaluationTimeKey{}) != nil || isDryRun(ctx) || requiresFresh(ctx) {
+		return cacheKey{}, false
+	}
+	attrs := fingerprint(p.buildAttributes(evalCtx))
+	if attrs == "" {
+		return cacheKey{}, false
+	}
+	return cacheKey{flag: flag, kind: kind, attrs: attrs}, true
+}
+
+// cachedDetail returns the detail of a cache hit: the CACHED reason, with the
+// reason of the original evaluation kept as "originalReason" metadata
+func cachedDetail(detail openfeature.ProviderResolutionDetail) openfeature.ProviderResolutionDetail {
+	metadata := make(openfeature.FlagMetadata, len(detail.FlagMetadata)+1)
+	for key, value := range detail.FlagMetadata {
+		metadata[key] = value
+	}
+	metadata["originalReason"] = string(detail.Reason)
+	detail.FlagMetadata = metadata
+	detail.Reason = openfeature.CachedReason
+	return detail
+}
@@ -0,0 +1,131 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestEvaluationCacheHitReportsCachedReason(t *testing.T) {
+	provider := setupTestProvider(WithEvaluationCache(time.Minute))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	evalCtx := openfeature.FlattenedContext{"email": "user@growthbook.com"}
+
+	fresh := provider.BooleanEvaluation(context.Background(), "rules-test", false, evalCtx)
+	if fresh.Reason != openfeature.TargetingMatchReason {
+		t.Fatalf("Expected a fresh TARGETING_MATCH result, got %s", fresh.Reason)
+	}
+
+	cached := provider.BooleanEvaluation(context.Background(), "rules-test", false, evalCtx)
+	if !cached.Value {
+		t.Error("Expected the cached value")
+	}
+	if cached.Reason != openfeature.CachedReason {
+		t.Errorf("Expected the CACHED reason, got %s", cached.Reason)
+	}
+	if cached.FlagMetadata["originalReason"] != string(openfeature.TargetingMatchReason) {
+		t.Errorf("Expected the original reason in metadata, got %v", cached.FlagMetadata)
+	}
+	if cached.Variant != fresh.Variant || cached.FlagMetadata["source"] != fresh.FlagMetadata["source"] {
+		t.Errorf("Expected the original variant and metadata to be kept, got %+v", cached.ProviderResolutionDetail)
+	}
+	if _, ok := fresh.FlagMetadata["originalReason"]; ok {
+		t.Error("Expected the fresh result's metadata to be left unchanged")
+	}
+
+	// Other attributes aren't served from the cache
+	other := provider.BooleanEvaluation(context.Background(), "rules-test", false, openfeature.FlattenedContext{"email": "other@example.com"})
+	if other.Value || other.Reason == openfeature.CachedReason {
+		t.Errorf("Expected a fresh result for other attributes, got %v (%s)", other.Value, other.Reason)
+	}
+}
+
+func TestEvaluationCacheExpiresAndInvalidates(t *testing.T) {
+	clock := newFakeClock()
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{"banner": {"defaultValue": "v1"}}`))
+	provider := NewProvider(gbClient, false, WithEvaluationCache(time.Minute), WithClock(clock.Now))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	provider.StringEvaluation(context.Background(), "banner", "", nil)
+	clock.Advance(time.Minute)
+	if result := provider.StringEvaluation(context.Background(), "banner", "", nil); result.Reason == openfeature.CachedReason {
+		t.Error("Expected the entry to expire after the TTL")
+	}
+
+	_ = gbClient.SetJSONFeatures(`{"banner": {"defaultValue": "v2"}}`)
+	result := provider.StringEvaluation(context.Background(), "banner", "", nil)
+	if result.Value != "v2" || result.Reason == openfeature.CachedReason {
+		t.Errorf("Expected a fresh v2 after features changed, got %q (%s)", result.Value, result.Reason)
+	}
+}
+
+func TestEvaluationCacheSkipsDefaults(t *testing.T) {
+	provider := setupTestProvider(WithEvaluationCache(time.Minute))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	provider.StringEvaluation(context.Background(), "missing-flag", "first", nil)
+	result := provider.StringEvaluation(context.Background(), "missing-flag", "second", nil)
+	if result.Value != "second" || result.Reason == openfeature.CachedReason {
+		t.Errorf("Expected missing flags not to be cached, got %q (%s)", result.Value, result.Reason)
+	}
+}
+
+// regionKey carries the region of the request in the tests' contexts
+type regionKey struct{}
+
+func TestEvaluationCacheKeysOnSourcedAttributes(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"eu-banner": {"defaultValue": "global", "rules": [{"condition": {"region": "eu"}, "force": "gdpr"}]}
+	}`))
+	region := attributeSourceFunc(func(ctx context.Context) (map[string]interface{}, error) {
+		return map[string]interface{}{"region": ctx.Value(regionKey{})}, nil
+	})
+	provider := NewProvider(gbClient, false, WithAttributeSources(region), WithEvaluationCache(time.Minute), WithEvaluationHistory(2))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	evalCtx := openfeature.FlattenedContext{"id": "user-1"}
+	eu := context.WithValue(context.Background(), regionKey{}, "eu")
+	us := context.WithValue(context.Background(), regionKey{}, "us")
+	if result := provider.StringEvaluation(eu, "eu-banner", "", evalCtx); result.Value != "gdpr" {
+		t.Fatalf("Expected the EU user to get gdpr, got %q (%s)", result.Value, result.Reason)
+	}
+	result := provider.StringEvaluation(us, "eu-banner", "", evalCtx)
+	if result.Value != "global" || result.Reason == openfeature.CachedReason {
+		t.Errorf("Expected a fresh global result for the US user, got %q (%s)", result.Value, result.Reason)
+	}
+
+	records := provider.RecentEvaluations()
+	if len(records) != 2 || records[0].AttributesFingerprint == records[1].AttributesFingerprint {
+		t.Errorf("Expected the sourced attributes to change the fingerprint, got %+v", records)
+	}
+}
+
+func TestEvaluationCacheKeysOnResolvedAttributes(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"pro-feature": {"defaultValue": false, "rules": [{"condition": {"plan": "pro"}, "force": true}]}
+	}`))
+	plans := map[string]string{"team-pro": "pro", "team-free": "free"}
+	provider := NewProvider(gbClient, false, WithEvaluationCache(time.Minute),
+		WithAttributeResolver(func(ctx context.Context, attrs map[string]interface{}) map[string]interface{} {
+			team, _ := attrs["teamId"].(string)
+			return map[string]interface{}{"plan": plans[team]}
+		}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	if result := provider.BooleanEvaluation(context.Background(), "pro-feature", false, openfeature.FlattenedContext{"teamId": "team-pro"}); !result.Value {
+		t.Fatalf("Expected the pro team to get the feature, got %v (%s)", result.Value, result.Reason)
+	}
+	if result := provider.BooleanEvaluation(context.Background(), "pro-feature", false, openfeature.FlattenedContext{"teamId": "team-pro"}); result.Reason != openfeature.CachedReason {
+		t.Errorf("Expected the same team to be served from the cache, got %s", result.Reason)
+	}
+
+	// Only the resolved plan changes, the explicit attributes stay the same
+	plans["team-pro"] = "free"
+	result := provider.BooleanEvaluation(context.Background(), "pro-feature", false, openfeature.FlattenedContext{"teamId": "team-pro"})
+	if result.Value || result.Reason == openfeature.CachedReason {
+		t.Errorf("Expected a fresh result once the resolved plan changed, got %v (%s)", result.Value, result.Reason)
+	}
+}
@@ -0,0 +1,43 @@
+package growthbook
+
+import (
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// Clone returns a new provider with the same configuration whose child
+// GrowthBook client carries attrs. The clone shares the loaded features of the
+// parent but doesn't rebuild a child client for every evaluation, which makes
+// it a cheap way to isolate repeated evaluations within a request.
+//
+// Attributes in an evaluation context passed to the clone are merged over
+// attrs, and a clone of a clone merges attrs over the attributes it already
+// carries. The clone is ready whenever the parent is; shutting it down doesn't
+// close the parent's client.
+func (p *Provider) Clone(attrs map[string]interface{}) *Provider {
+	// The application sets attrs, so the allowlist doesn't apply to them
+	cloneAttrs := p.mergeAttributes(attrs, false)
+	baseAttrs := p.buildAttributes(nil)
+	for k, v := range cloneAttrs {
+		baseAttrs[k] = v
+	}
+
+	var child *gb.Client
+	if p.parent != nil {
+		child, _ = p.gbClient.WithAttributeOverrides(cloneAttrs)
+	} else {
+		child, _ = p.gbClient.WithAttributes(baseAttrs)
+		child = p.withTracking(child)
+	}
+
+	clone := &Provider{
+		config:    p.config,
+		gbClient:  child,
+		events:    make(chan openfeature.Event, eventBufferSize),
+		parent:    p,
+		baseAttrs: baseAttrs,
+	}
+	// Static hints are already part of the clone's attributes
+	clone.staticHints = nil
+	return clone
+}
@@ -0,0 +1,154 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestCloneCarriesAttributes(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	clone := provider.Clone(map[string]interface{}{"email": "user@growthbook.com"})
+
+	result := clone.BooleanEvaluation(context.Background(), "rules-test", false, nil)
+	if !result.Value {
+		t.Error("Expected clone attributes to match the rule")
+	}
+
+	// The parent is unaffected by the clone's attributes
+	result = provider.BooleanEvaluation(context.Background(), "rules-test", false, nil)
+	if result.Value {
+		t.Error("Expected parent provider not to carry the clone's attributes")
+	}
+
+	// Per-call attributes are merged over the clone's attributes
+	result = clone.BooleanEvaluation(context.Background(), "rules-test", false, openfeature.FlattenedContext{"email": "foo@bar.com"})
+	if result.Value {
+		t.Error("Expected per-call attributes to override clone attributes")
+	}
+}
+
+func TestCloneSharesFeatureData(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+	clone := provider.Clone(nil)
+
+	_ = provider.GetClient().SetJSONFeatures(`{"new-flag": {"defaultValue": "fresh"}}`)
+
+	result := clone.StringEvaluation(context.Background(), "new-flag", "stale", nil)
+	if result.Value != "fresh" {
+		t.Errorf("Expected clone to see features loaded by the parent, got %q", result.Value)
+	}
+}
+
+func TestCloneShutdownKeepsParentRunning(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+	clone := provider.Clone(nil)
+
+	clone.Shutdown()
+
+	if clone.Status() != openfeature.NotReadyState {
+		t.Errorf("Expected clone to be not ready after shutdown, got %v", clone.Status())
+	}
+	if provider.Status() != openfeature.ReadyState {
+		t.Errorf("Expected parent to stay ready, got %v", provider.Status())
+	}
+	result := provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+	if !result.Value {
+		t.Error("Expected parent to keep evaluating after clone shutdown")
+	}
+}
+
+func TestCloneOfCloneKeepsAttributes(t *testing.T) {
+	featuresJSON := `{
+		"both": {
+			"defaultValue": false,
+			"rules": [{"condition": {"email": "user@growthbook.com", "country": "NZ"}, "force": true}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	clone := provider.Clone(map[string]interface{}{"email": "user@growthbook.com"})
+	grandchild := clone.Clone(map[string]interface{}{"country": "NZ"})
+
+	result := grandchild.BooleanEvaluation(context.Background(), "both", false, nil)
+	if !result.Value {
+		t.Error("Expected a clone of a clone to carry the attributes of both clones")
+	}
+}
+
+func TestCloneFollowsParentReadiness(t *testing.T) {
+	provider := setupTestProvider()
+	clone := provider.Clone(nil)
+
+	if clone.Status() != openfeature.NotReadyState {
+		t.Errorf("Expected clone of an uninitialized provider to be not ready, got %v", clone.Status())
+	}
+
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+	if clone.Status() != openfeature.ReadyState {
+		t.Errorf("Expected clone to become ready with its parent, got %v", clone.Status())
+	}
+	result := clone.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+	if !result.Value {
+		t.Error("Expected clone to evaluate once its parent is ready")
+	}
+
+	provider.Shutdown()
+	if clone.Status() != openfeature.NotReadyState {
+		t.Errorf("Expected clone to be not ready after its parent shut down, got %v", clone.Status())
+	}
+}
+
+func TestCloneAttributesFeedFallbackAndHints(t *testing.T) {
+	featuresJSON := `{
+		"checkout": {
+			"defaultValue": "none",
+			"rules": [{"condition": {"platform": "ios"}, "variations": ["a", "b"]}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, false,
+		WithStaticHints(map[string]interface{}{"platform": "ios"}),
+		WithFallbackAttributes(map[string]string{"checkout": "deviceId"}),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	clone := provider.Clone(map[string]interface{}{"deviceId": "device-42"})
+	result := clone.StringEvaluation(context.Background(), "checkout", "fallback", nil)
+	if result.Value == "none" {
+		t.Error("Expected the clone's deviceId and the static hints to bucket the user")
+	}
+	if result.FlagMetadata["hashAttribute"] != "deviceId" {
+		t.Errorf("Expected hashAttribute metadata 'deviceId', got %v", result.FlagMetadata["hashAttribute"])
+	}
+}
+
+func TestCloneAttributesBypassAllowlist(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"pro-feature": {"defaultValue": false, "rules": [{"condition": {"plan": "pro"}, "force": true}]}
+	}`))
+	provider := NewProvider(gbClient, false, WithAttributeAllowlist([]string{"id"}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	clone := provider.Clone(map[string]interface{}{"plan": "pro"})
+	if result := clone.BooleanEvaluation(context.Background(), "pro-feature", false, nil); !result.Value {
+		t.Error("Expected the clone's attributes not to be filtered by the allowlist")
+	}
+	nested := clone.Clone(map[string]interface{}{"team": "core"})
+	if result := nested.BooleanEvaluation(context.Background(), "pro-feature", false, nil); !result.Value {
+		t.Error("Expected a clone of a clone to keep the unfiltered attributes")
+	}
+
+	// Context attributes are still filtered
+	if result := provider.BooleanEvaluation(context.Background(), "pro-feature", false, openfeature.FlattenedContext{"plan": "pro"}); result.Value {
+		t.Error("Expected context attributes missing from the allowlist to be dropped")
+	}
+}
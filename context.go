@@ -0,0 +1,66 @@
+package growthbook
+
+import "github.com/open-feature/go-sdk/openfeature"
+
+// ContextMergeFunc combines several flattened evaluation contexts (for example
+// a user context and a device context) into the single attribute set that
+// GrowthBook evaluates against.
+type ContextMergeFunc func(contexts ...openfeature.FlattenedContext) openfeature.FlattenedContext
+
+// ShallowMerge is the default ContextMergeFunc. Top-level keys of later
+// contexts overwrite the same keys of earlier ones; nested values are not merged.
+func ShallowMerge(contexts ...openfeature.FlattenedContext) openfeature.FlattenedContext {
+	merged := openfeature.FlattenedContext{}
+	for _, c := range contexts {
+		for k, v := range c {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+// MergeContexts combines the given contexts using the provider's merge strategy.
+// The result can be passed to any of the evaluation methods.
+func (p *Provider) MergeContexts(contexts ...openfeature.FlattenedContext) openfeature.FlattenedContext {
+	if p.contextMerge == nil {
+		return ShallowMerge(contexts...)
+	}
+	return p.contextMerge(contexts...)
+}
+
+// mergeNestedContexts merges the contexts held under the keys configured with
+// WithNestedContexts into the top level of evalCtx.
+func (p *Provider) mergeNestedContexts(evalCtx openfeature.FlattenedContext) openfeature.FlattenedContext {
+	if len(p.nestedContexts) == 0 || len(evalCtx) == 0 {
+		return evalCtx
+	}
+
+	topLevel := make(openfeature.FlattenedContext, len(evalCtx))
+	for k, v := range evalCtx {
+		topLevel[k] = v
+	}
+	contexts := []openfeature.FlattenedContext{topLevel}
+	for _, key := range p.nestedContexts {
+		nested, ok := asContext(evalCtx[key])
+		if !ok {
+			continue
+		}
+		delete(topLevel, key)
+		contexts = append(contexts, nested)
+	}
+	if len(contexts) == 1 {
+		return evalCtx
+	}
+	return p.MergeContexts(contexts...)
+}
+
+// asContext returns value as a flattened context if it's a map of attributes
+func asContext(value interface{}) (openfeature.FlattenedContext, bool) {
+	switch v := value.(type) {
+	case openfeature.FlattenedContext:
+		return v, true
+	case map[string]interface{}:
+		return v, true
+	}
+	return nil, false
+}
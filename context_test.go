@@ -0,0 +1,134 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+const multiContextFeatures = `{
+	"mobile-beta": {
+		"defaultValue": false,
+		"rules": [
+			{
+				"condition": {"plan": "pro", "platform": "ios"},
+				"force": true
+			}
+		]
+	}
+}`
+
+func TestMergeContextsDefaultLastWins(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(multiContextFeatures))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	user := openfeature.FlattenedContext{"id": "user-1", "plan": "pro", "platform": "web"}
+	device := openfeature.FlattenedContext{"deviceId": "device-1", "platform": "ios"}
+
+	merged := provider.MergeContexts(user, device)
+	if merged["platform"] != "ios" {
+		t.Errorf("Expected last context to win for platform, got %v", merged["platform"])
+	}
+	if merged["plan"] != "pro" || merged["deviceId"] != "device-1" {
+		t.Errorf("Expected attributes from both contexts, got %v", merged)
+	}
+
+	result := provider.BooleanEvaluation(context.Background(), "mobile-beta", false, merged)
+	if !result.Value {
+		t.Error("Expected merged attributes to match the rule")
+	}
+
+	// Reversing the order lets the user's platform win and the rule no longer matches
+	result = provider.BooleanEvaluation(context.Background(), "mobile-beta", false, provider.MergeContexts(device, user))
+	if result.Value {
+		t.Error("Expected rule not to match when the user context wins")
+	}
+}
+
+func TestMergeContextsCustomStrategy(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(multiContextFeatures))
+
+	// First context wins
+	firstWins := func(contexts ...openfeature.FlattenedContext) openfeature.FlattenedContext {
+		merged := openfeature.FlattenedContext{}
+		for i := len(contexts) - 1; i >= 0; i-- {
+			for k, v := range contexts[i] {
+				merged[k] = v
+			}
+		}
+		return merged
+	}
+	provider := NewProvider(gbClient, 5*time.Second, false, WithContextMerge(firstWins))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	device := openfeature.FlattenedContext{"platform": "ios"}
+	user := openfeature.FlattenedContext{"plan": "pro", "platform": "web"}
+
+	result := provider.BooleanEvaluation(context.Background(), "mobile-beta", false, provider.MergeContexts(device, user))
+	if !result.Value {
+		t.Error("Expected custom merge strategy to keep the device platform")
+	}
+}
+
+func TestNestedContextsMergedIntoEvaluation(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(multiContextFeatures))
+	provider := NewProvider(gbClient, false, WithNestedContexts("device"))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	evalCtx := openfeature.FlattenedContext{
+		"plan":     "pro",
+		"platform": "web",
+		"device":   map[string]interface{}{"platform": "ios"},
+	}
+	result := provider.BooleanEvaluation(context.Background(), "mobile-beta", false, evalCtx)
+	if !result.Value {
+		t.Error("Expected the nested device context to win with the default merge")
+	}
+
+	// Without the option the nested context is only an ordinary attribute
+	plain := NewProvider(gbClient, false)
+	_ = plain.Init(openfeature.NewEvaluationContext("", nil))
+	result = plain.BooleanEvaluation(context.Background(), "mobile-beta", false, evalCtx)
+	if result.Value {
+		t.Error("Expected nested contexts not to be merged unless configured")
+	}
+}
+
+func TestNestedContextsUseMergeStrategy(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(multiContextFeatures))
+
+	// Keep the first value seen for every key
+	firstWins := func(contexts ...openfeature.FlattenedContext) openfeature.FlattenedContext {
+		merged := openfeature.FlattenedContext{}
+		for _, c := range contexts {
+			for k, v := range c {
+				if _, ok := merged[k]; !ok {
+					merged[k] = v
+				}
+			}
+		}
+		return merged
+	}
+	provider := NewProvider(gbClient, false, WithNestedContexts("device"), WithContextMerge(firstWins))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	evalCtx := openfeature.FlattenedContext{
+		"plan":     "pro",
+		"platform": "web",
+		"device":   map[string]interface{}{"platform": "ios"},
+	}
+	result := provider.BooleanEvaluation(context.Background(), "mobile-beta", false, evalCtx)
+	if result.Value {
+		t.Error("Expected the custom strategy to keep the top-level platform")
+	}
+
+	delete(evalCtx, "platform")
+	result = provider.BooleanEvaluation(context.Background(), "mobile-beta", false, evalCtx)
+	if !result.Value {
+		t.Error("Expected the nested platform when the top level has none")
+	}
+}
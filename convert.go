@@ -0,0 +1,141 @@
+package growthbook
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// The conversions below are the pure core of the typed evaluations: each
+// reports whether a GrowthBook value has the requested type. Values decoded
+// from GrowthBook's JSON are float64, string, bool, []interface{} or
+// map[string]interface{}, but values set in code may use other Go types.
+
+// derefValue dereferences a value set in code as a pointer, such as a *bool
+// override, so it converts like the value it points to. A nil pointer is
+// returned as nil, a flag without a value.
+func derefValue(value interface{}) interface{} {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
+
+// toBool converts a boolean GrowthBook value
+func toBool(value interface{}) (bool, bool) {
+	v, ok := value.(bool)
+	return v, ok
+}
+
+// toString converts a string GrowthBook value
+func toString(value interface{}) (string, bool) {
+	v, ok := value.(string)
+	return v, ok
+}
+
+// toObject accepts any GrowthBook value, as object flags may hold any JSON
+func toObject(value interface{}) (interface{}, bool) {
+	return value, true
+}
+
+// toFloat64 converts a numeric GrowthBook value to float64. Strings are parsed
+// only if parseStrings is set, and must hold a finite number: JSON can't
+// represent NaN or infinity, so "NaN" or "Inf" is a label rather than a number.
+func toFloat64(value interface{}, parseStrings bool) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case json.Number:
+		return parseFloat(string(v))
+	case string:
+		if parseStrings {
+			return parseFloat(v)
+		}
+	}
+	return 0, false
+}
+
+// toInt64 converts a numeric GrowthBook value to int64. Floats must hold an
+// integer that fits in an int64, so values are never truncated. Strings are
+// parsed only if parseStrings is set, and must hold an integer.
+func toInt64(value interface{}, parseStrings bool) (int64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(v), true
+	case float64:
+		return floatToInt64(v)
+	case float32:
+		return floatToInt64(float64(v))
+	case json.Number:
+		if parsed, err := strconv.ParseInt(string(v), 10, 64); err == nil {
+			return parsed, true
+		}
+	case string:
+		if parseStrings {
+			if parsed, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return parsed, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// parseFloat parses a finite float
+func parseFloat(s string) (float64, bool) {
+	parsed, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(parsed) || math.IsInf(parsed, 0) {
+		return 0, false
+	}
+	return parsed, true
+}
+
+// floatToInt64 converts a float holding an integer within the int64 range
+func floatToInt64(v float64) (int64, bool) {
+	// 2^63 itself doesn't fit in an int64, but -2^63 does
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return 0, false
+	}
+	return int64(v), true
+}
+
+// isEmptyObject reports whether value is an empty JSON object or array
+func isEmptyObject(value interface{}) bool {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,333 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func setupNumericStringProvider(enabled bool) *Provider {
+	featuresJSON := `{
+		"page-size": {"defaultValue": "10"},
+		"ratio": {"defaultValue": "3.14"},
+		"label": {"defaultValue": "ten"},
+		"not-a-number": {"defaultValue": "NaN"},
+		"infinite": {"defaultValue": "-Inf"}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, 5*time.Second, false, WithNumericStringParsing(enabled))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	return provider
+}
+
+func TestNumericStringParsing(t *testing.T) {
+	provider := setupNumericStringProvider(true)
+	ctx := context.Background()
+
+	intResult := provider.IntEvaluation(ctx, "page-size", 0, nil)
+	if intResult.Value != 10 || intResult.ResolutionDetail().ErrorCode != "" {
+		t.Errorf("Expected \"10\" to parse as int 10, got %d (%s)", intResult.Value, intResult.ResolutionDetail().ErrorCode)
+	}
+
+	floatResult := provider.FloatEvaluation(ctx, "ratio", 0, nil)
+	if floatResult.Value != 3.14 || floatResult.ResolutionDetail().ErrorCode != "" {
+		t.Errorf("Expected \"3.14\" to parse as float 3.14, got %v (%s)", floatResult.Value, floatResult.ResolutionDetail().ErrorCode)
+	}
+
+	intResult = provider.IntEvaluation(ctx, "label", 7, nil)
+	if intResult.Value != 7 || intResult.ResolutionDetail().ErrorCode != openfeature.TypeMismatchCode {
+		t.Errorf("Expected non-numeric string to be a type mismatch, got %d (%s)", intResult.Value, intResult.ResolutionDetail().ErrorCode)
+	}
+
+	floatResult = provider.FloatEvaluation(ctx, "label", 1.5, nil)
+	if floatResult.Value != 1.5 || floatResult.ResolutionDetail().ErrorCode != openfeature.TypeMismatchCode {
+		t.Errorf("Expected non-numeric string to be a type mismatch, got %v (%s)", floatResult.Value, floatResult.ResolutionDetail().ErrorCode)
+	}
+
+	for _, flag := range []string{"not-a-number", "infinite"} {
+		floatResult = provider.FloatEvaluation(ctx, flag, 1.5, nil)
+		if floatResult.Value != 1.5 || floatResult.ResolutionDetail().ErrorCode != openfeature.TypeMismatchCode {
+			t.Errorf("Expected %q to be a type mismatch, got %v (%s)", flag, floatResult.Value, floatResult.ResolutionDetail().ErrorCode)
+		}
+	}
+}
+
+func TestNumericStringParsingDisabledByDefault(t *testing.T) {
+	provider := setupNumericStringProvider(false)
+
+	result := provider.IntEvaluation(context.Background(), "page-size", 0, nil)
+	if result.ResolutionDetail().ErrorCode != openfeature.TypeMismatchCode {
+		t.Errorf("Expected strict type mismatch for numeric string, got %s", result.ResolutionDetail().ErrorCode)
+	}
+}
+
+// fuzzValue builds a GrowthBook value of the Go type selected by kind
+func fuzzValue(kind uint8, f float64, i int64, s string, b bool) interface{} {
+	switch kind % 13 {
+	case 0:
+		return nil
+	case 1:
+		return b
+	case 2:
+		return s
+	case 3:
+		return f
+	case 4:
+		return float32(f)
+	case 5:
+		return int(i)
+	case 6:
+		return i
+	case 7:
+		return int32(i)
+	case 8:
+		return uint64(i)
+	case 9:
+		return json.Number(s)
+	case 10:
+		return map[string]interface{}{"key": s, "n": f}
+	case 11:
+		return []interface{}{b, s}
+	default:
+		return float64(i)
+	}
+}
+
+// FuzzEvaluationTypes checks the invariants of the typed evaluations for
+// arbitrary values: conversions never truncate or invent values, every
+// evaluation either resolves the flag's value or returns the default with a
+// consistent reason and error code, and nothing panics. Run it with
+//
+//	go test -run '^$' -fuzz FuzzEvaluationTypes
+func FuzzEvaluationTypes(f *testing.F) {
+	f.Add(uint8(1), 0.0, int64(0), "", true)
+	f.Add(uint8(3), 42.5, int64(42), "42", false)
+	f.Add(uint8(9), 0.0, int64(0), "1e3", false)
+	f.Add(uint8(12), float64(math.MaxInt64), int64(math.MinInt64), "NaN", false)
+	f.Add(uint8(4), 3.7, int64(-1), "-Inf", true)
+
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{}`))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	f.Fuzz(func(t *testing.T, kind uint8, fv float64, iv int64, sv string, bv bool) {
+		value := fuzzValue(kind, fv, iv, sv, bv)
+		checkConversions(t, value)
+
+		features, err := json.Marshal(map[string]interface{}{"flag": map[string]interface{}{"defaultValue": value}})
+		if err != nil {
+			// NaN, infinities and invalid json.Numbers can't come from GrowthBook
+			return
+		}
+		if err := gbClient.SetJSONFeatures(string(features)); err != nil {
+			return
+		}
+		checkEvaluations(t, provider)
+	})
+}
+
+// checkConversions checks the conversions of a value against its Go type
+func checkConversions(t *testing.T, value interface{}) {
+	if _, ok := toBool(value); ok != isType[bool](value) {
+		t.Errorf("toBool(%#v) ok = %v", value, ok)
+	}
+	if _, ok := toString(value); ok != isType[string](value) {
+		t.Errorf("toString(%#v) ok = %v", value, ok)
+	}
+	if converted, ok := toFloat64(value, true); ok && (math.IsNaN(converted) || math.IsInf(converted, 0)) {
+		t.Errorf("toFloat64(%#v) = %v, want a finite number", value, converted)
+	}
+	if converted, ok := toInt64(value, true); ok {
+		if original, isFloat := toFloat64(value, true); isFloat && original != float64(converted) {
+			t.Errorf("toInt64(%#v) = %d truncates %v", value, converted, original)
+		}
+	}
+}
+
+func isType[T any](value interface{}) bool {
+	_, ok := value.(T)
+	return ok
+}
+
+// checkEvaluations evaluates "flag" as every type and checks each result is
+// either its value or the default with a matching reason and error code
+func checkEvaluations(t *testing.T, provider *Provider) {
+	ctx := context.Background()
+	feature := provider.GetClient().EvalFeature(ctx, "flag")
+
+	boolResult := provider.BooleanEvaluation(ctx, "flag", true, nil)
+	checkDetail(t, "boolean", feature.Value, boolResult.Value, true, boolResult.ProviderResolutionDetail)
+	stringResult := provider.StringEvaluation(ctx, "flag", "default", nil)
+	checkDetail(t, "string", feature.Value, stringResult.Value, "default", stringResult.ProviderResolutionDetail)
+	floatResult := provider.FloatEvaluation(ctx, "flag", -1.5, nil)
+	checkDetail(t, "float", feature.Value, floatResult.Value, -1.5, floatResult.ProviderResolutionDetail)
+	intResult := provider.IntEvaluation(ctx, "flag", -7, nil)
+	checkDetail(t, "int", feature.Value, intResult.Value, int64(-7), intResult.ProviderResolutionDetail)
+	objectResult := provider.ObjectEvaluation(ctx, "flag", "default", nil)
+	if objectResult.ResolutionDetail().ErrorCode != "" {
+		t.Errorf("object: unexpected error %s for %#v", objectResult.ResolutionDetail().ErrorCode, feature.Value)
+	}
+}
+
+func checkDetail[T comparable](t *testing.T, kind string, raw interface{}, value T, defaultValue T, detail openfeature.ProviderResolutionDetail) {
+	t.Helper()
+	code := detail.ResolutionDetail().ErrorCode
+	switch {
+	case raw == nil:
+		if value != defaultValue || detail.Reason != openfeature.DefaultReason || code != "" {
+			t.Errorf("%s: expected default with DEFAULT reason for a null value, got %v (%s, %s)", kind, value, detail.Reason, code)
+		}
+	case code == openfeature.TypeMismatchCode:
+		if value != defaultValue || detail.Reason != openfeature.ErrorReason {
+			t.Errorf("%s: expected default with ERROR reason on mismatch of %#v, got %v (%s)", kind, raw, value, detail.Reason)
+		}
+	case code != "":
+		t.Errorf("%s: unexpected error %s for %#v", kind, code, raw)
+	default:
+		if detail.Reason == openfeature.ErrorReason {
+			t.Errorf("%s: expected a non-error reason for resolved %#v", kind, raw)
+		}
+		if !sameValue(value, raw) {
+			t.Errorf("%s: resolved %v, want the flag's value %v", kind, value, raw)
+		}
+	}
+}
+
+// sameValue compares a resolved value with the flag's raw value, numerically
+// if both are numbers
+func sameValue(resolved interface{}, raw interface{}) bool {
+	a, aNumeric := toFloat64(resolved, false)
+	b, bNumeric := toFloat64(raw, false)
+	if aNumeric && bNumeric {
+		return a == b
+	}
+	return fmt.Sprint(resolved) == fmt.Sprint(raw)
+}
+
+func setupEmptyObjectProvider(enabled bool) *Provider {
+	featuresJSON := `{
+		"empty-map": {"defaultValue": {}},
+		"empty-list": {"defaultValue": []},
+		"config": {"defaultValue": {"theme": "dark"}}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, 5*time.Second, false, WithTreatEmptyObjectAsDefault(enabled))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	return provider
+}
+
+func TestEmptyObjectAsDefault(t *testing.T) {
+	provider := setupEmptyObjectProvider(true)
+	ctx := context.Background()
+	fallback := map[string]interface{}{"theme": "light"}
+
+	for _, flag := range []string{"empty-map", "empty-list"} {
+		result := provider.ObjectEvaluation(ctx, flag, fallback, nil)
+		if fmt.Sprint(result.Value) != fmt.Sprint(fallback) {
+			t.Errorf("Expected %s to resolve to the default value, got %v", flag, result.Value)
+		}
+		detail := result.ResolutionDetail()
+		if detail.Reason != openfeature.DefaultReason || detail.ErrorCode != "" {
+			t.Errorf("Expected %s to resolve with the DEFAULT reason and no error, got %s (%s)", flag, detail.Reason, detail.ErrorCode)
+		}
+		if detail.FlagMetadata["emptyObject"] != true {
+			t.Errorf("Expected emptyObject metadata for %s, got %v", flag, detail.FlagMetadata)
+		}
+
+		jsonResult := provider.ObjectEvaluationJSON(ctx, flag, json.RawMessage(`{"theme":"light"}`), nil)
+		if string(jsonResult.Value) != `{"theme":"light"}` || jsonResult.FlagMetadata["emptyObject"] != true {
+			t.Errorf("Expected %s to resolve to the default JSON, got %s (%v)", flag, jsonResult.Value, jsonResult.FlagMetadata)
+		}
+	}
+
+	result := provider.ObjectEvaluation(ctx, "config", fallback, nil)
+	if fmt.Sprint(result.Value) != fmt.Sprint(map[string]interface{}{"theme": "dark"}) {
+		t.Errorf("Expected a non-empty object to be returned as it is, got %v", result.Value)
+	}
+	if _, ok := result.FlagMetadata["emptyObject"]; ok {
+		t.Errorf("Expected no emptyObject metadata for a non-empty object, got %v", result.FlagMetadata)
+	}
+}
+
+func TestEmptyObjectReturnedWhenDisabled(t *testing.T) {
+	provider := setupEmptyObjectProvider(false)
+
+	result := provider.ObjectEvaluation(context.Background(), "empty-list", map[string]interface{}{}, nil)
+	if list, ok := result.Value.([]interface{}); !ok || len(list) != 0 {
+		t.Errorf("Expected the empty array to be returned, got %v", result.Value)
+	}
+	if _, ok := result.FlagMetadata["emptyObject"]; ok {
+		t.Errorf("Expected no emptyObject metadata when disabled, got %v", result.FlagMetadata)
+	}
+}
+
+func TestPointerValuesResolve(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	ctx := context.Background()
+
+	b, s, f, i := true, "pointed", 2.5, int64(7)
+	provider.SetOverride("bool-flag", &b)
+	provider.SetOverride("string-flag", &s)
+	provider.SetOverride("number-flag", &f)
+	provider.SetOverride("int-flag", &i)
+
+	if result := provider.BooleanEvaluation(ctx, "bool-flag", false, nil); !result.Value || result.ResolutionDetail().ErrorCode != "" {
+		t.Errorf("Expected *bool to resolve as true, got %v (%s)", result.Value, result.ResolutionDetail().ErrorCode)
+	}
+	if result := provider.StringEvaluation(ctx, "string-flag", "", nil); result.Value != "pointed" {
+		t.Errorf("Expected *string to resolve, got %q (%s)", result.Value, result.ResolutionDetail().ErrorCode)
+	}
+	if result := provider.FloatEvaluation(ctx, "number-flag", 0, nil); result.Value != 2.5 {
+		t.Errorf("Expected *float64 to resolve, got %v (%s)", result.Value, result.ResolutionDetail().ErrorCode)
+	}
+	if result := provider.IntEvaluation(ctx, "int-flag", 0, nil); result.Value != 7 {
+		t.Errorf("Expected *int64 to resolve, got %d (%s)", result.Value, result.ResolutionDetail().ErrorCode)
+	}
+
+	// Nil pointers are flags without a value
+	provider.SetOverride("bool-flag", (*bool)(nil))
+	provider.SetOverride("string-flag", (*string)(nil))
+	provider.SetOverride("number-flag", (*float64)(nil))
+	provider.SetOverride("int-flag", (*int64)(nil))
+
+	if result := provider.BooleanEvaluation(ctx, "bool-flag", true, nil); !result.Value || result.Reason != openfeature.DefaultReason {
+		t.Errorf("Expected the default for a nil *bool, got %v (%s)", result.Value, result.Reason)
+	}
+	if result := provider.StringEvaluation(ctx, "string-flag", "fallback", nil); result.Value != "fallback" || result.Reason != openfeature.DefaultReason {
+		t.Errorf("Expected the default for a nil *string, got %q (%s)", result.Value, result.Reason)
+	}
+	if result := provider.FloatEvaluation(ctx, "number-flag", 1.5, nil); result.Value != 1.5 || result.Reason != openfeature.DefaultReason {
+		t.Errorf("Expected the default for a nil *float64, got %v (%s)", result.Value, result.Reason)
+	}
+	if result := provider.IntEvaluation(ctx, "int-flag", 3, nil); result.Value != 3 || result.Reason != openfeature.DefaultReason {
+		t.Errorf("Expected the default for a nil *int64, got %d (%s)", result.Value, result.Reason)
+	}
+}
+
+func TestDerefValue(t *testing.T) {
+	n := 42
+	p := &n
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{"nil", nil, nil},
+		{"scalar", "plain", "plain"},
+		{"pointer", &n, 42},
+		{"pointer to pointer", &p, 42},
+		{"nil pointer", (*string)(nil), nil},
+	}
+	for _, tt := range tests {
+		if got := derefValue(tt.value); got != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package growthbook
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// evaluationCounts counts the evaluations of every flag. Counting happens on
+// every evaluation, so flags seen before only take the read lock and an
+// atomic increment.
+type evaluationCounts struct {
+	mu     sync.RWMutex
+	counts map[string]*atomic.Uint64
+}
+
+func newEvaluationCounts() *evaluationCounts {
+	return &evaluationCounts{counts: make(map[string]*atomic.Uint64)}
+}
+
+func (c *evaluationCounts) increment(flag string) {
+	c.mu.RLock()
+	count, ok := c.counts[flag]
+	c.mu.RUnlock()
+	if !ok {
+		c.mu.Lock()
+		if count, ok = c.counts[flag]; !ok {
+			count = new(atomic.Uint64)
+			c.counts[flag] = count
+		}
+		c.mu.Unlock()
+	}
+	count.Add(1)
+}
+
+func (c *evaluationCounts) snapshot() map[string]uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	counts := make(map[string]uint64, len(c.counts))
+	for flag, count := range c.counts {
+		counts[flag] = count.Load()
+	}
+	return counts
+}
+
+// EvaluationCounts returns the number of evaluations of every flag evaluated
+// since the provider was created, including those of its clones. Flags
+// defined in GrowthBook but missing from the counts (see Snapshot) were never
+// evaluated, and may be dead.
+func (p *Provider) EvaluationCounts() map[string]uint64 {
+	return p.counts.snapshot()
+}
@@ -0,0 +1,41 @@
+package growthbook
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestEvaluationCounts(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			provider.BooleanEvaluation(ctx, "bool-flag", false, nil)
+		}()
+	}
+	wg.Wait()
+	provider.StringEvaluation(ctx, "string-flag", "", nil)
+	provider.ObjectEvaluationJSON(ctx, "object-flag", nil, nil)
+	provider.StringEvaluation(ctx, "missing-flag", "", nil)
+	// Clones count towards the provider they were derived from
+	provider.Clone(map[string]interface{}{"id": "user-1"}).StringEvaluation(ctx, "string-flag", "", nil)
+
+	counts := provider.EvaluationCounts()
+	expected := map[string]uint64{"bool-flag": 10, "string-flag": 2, "object-flag": 1, "missing-flag": 1}
+	for flag, count := range expected {
+		if counts[flag] != count {
+			t.Errorf("Expected %d evaluations of %s, got %d", count, flag, counts[flag])
+		}
+	}
+	if _, ok := counts["int-flag"]; ok {
+		t.Error("Expected flags never evaluated to be missing from the counts")
+	}
+}
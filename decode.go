@@ -0,0 +1,45 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// TypedResolutionDetail is the result of DecodeObject
+type TypedResolutionDetail[T any] struct {
+	Value T
+	openfeature.ProviderResolutionDetail
+}
+
+// DecodeObject evaluates an object flag and decodes its value into T, e.g. a
+// configuration struct, with the decoder set with WithObjectDecoder, or
+// json.Unmarshal by default.
+//
+// defaultValue is returned with the flag's error or reason if the flag can't
+// be resolved, and with a type mismatch if its value can't be decoded into T.
+func DecodeObject[T any](p *Provider, ctx context.Context, flag string, defaultValue T, evalCtx openfeature.FlattenedContext) TypedResolutionDetail[T] {
+	result := p.ObjectEvaluationJSON(ctx, flag, nil, evalCtx)
+	if len(result.Value) == 0 {
+		return TypedResolutionDetail[T]{Value: defaultValue, ProviderResolutionDetail: result.ProviderResolutionDetail}
+	}
+
+	decode := p.objectDecoder
+	if decode == nil {
+		decode = json.Unmarshal
+	}
+	var value T
+	if err := decode(result.Value, &value); err != nil {
+		return TypedResolutionDetail[T]{
+			Value: defaultValue,
+			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
+				ResolutionError: openfeature.NewTypeMismatchResolutionError(
+					fmt.Sprintf("flag '%s' can't be decoded into %T: %v", flag, value, err)),
+				Reason: openfeature.ErrorReason,
+			},
+		}
+	}
+	return TypedResolutionDetail[T]{Value: value, ProviderResolutionDetail: result.ProviderResolutionDetail}
+}
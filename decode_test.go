@@ -0,0 +1,72 @@
+package growthbook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+type checkoutConfig struct {
+	Theme    string   `json:"theme"`
+	MaxItems int      `json:"maxItems"`
+	Methods  []string `json:"methods"`
+}
+
+func setupDecodeProvider(options ...interface{}) *Provider {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"checkout": {"defaultValue": {"theme": "dark", "maxItems": 20, "methods": ["card", "paypal"], "legacy": true}},
+		"bad-checkout": {"defaultValue": {"maxItems": "twenty"}},
+		"banner": {"defaultValue": "hello"}
+	}`))
+	provider := NewProvider(gbClient, append([]interface{}{false}, options...)...)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	return provider
+}
+
+func TestDecodeObjectIntoStruct(t *testing.T) {
+	provider := setupDecodeProvider()
+	ctx := context.Background()
+	fallback := checkoutConfig{Theme: "light", MaxItems: 5}
+
+	result := DecodeObject(provider, ctx, "checkout", fallback, nil)
+	if result.Value.Theme != "dark" || result.Value.MaxItems != 20 || len(result.Value.Methods) != 2 {
+		t.Errorf("Expected the decoded config, got %+v", result.Value)
+	}
+	if result.Reason != openfeature.DefaultReason || result.ResolutionDetail().ErrorCode != "" {
+		t.Errorf("Expected GrowthBook's reason without an error, got %s (%s)", result.Reason, result.ResolutionDetail().ErrorCode)
+	}
+
+	notFound := DecodeObject(provider, ctx, "missing", fallback, nil)
+	if notFound.Value.Theme != "light" || notFound.Value.MaxItems != 5 {
+		t.Errorf("Expected the default config for a missing flag, got %+v", notFound.Value)
+	}
+	if notFound.ResolutionDetail().ErrorCode != openfeature.FlagNotFoundCode {
+		t.Errorf("Expected a FLAG_NOT_FOUND error, got %q", notFound.ResolutionDetail().ErrorCode)
+	}
+
+	for _, flag := range []string{"bad-checkout", "banner"} {
+		mismatch := DecodeObject(provider, ctx, flag, fallback, nil)
+		if mismatch.Value.Theme != "light" || mismatch.ResolutionDetail().ErrorCode != openfeature.TypeMismatchCode {
+			t.Errorf("Expected the default config with a TYPE_MISMATCH for %s, got %+v (%q)", flag, mismatch.Value, mismatch.ResolutionDetail().ErrorCode)
+		}
+	}
+}
+
+func TestDecodeObjectWithDecoder(t *testing.T) {
+	strict := func(data []byte, v interface{}) error {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		return decoder.Decode(v)
+	}
+	provider := setupDecodeProvider(WithObjectDecoder(strict))
+
+	// The flag's "legacy" field isn't part of checkoutConfig
+	result := DecodeObject(provider, context.Background(), "checkout", checkoutConfig{Theme: "light"}, nil)
+	if result.Value.Theme != "light" || result.ResolutionDetail().ErrorCode != openfeature.TypeMismatchCode {
+		t.Errorf("Expected the strict decoder to reject unknown fields, got %+v (%q)", result.Value, result.ResolutionDetail().ErrorCode)
+	}
+}
@@ -0,0 +1,57 @@
+package growthbook
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// defaultKey identifies a registered default by flag and value type
+type defaultKey struct {
+	flag string
+	typ  reflect.Type
+}
+
+// defaultsRegistry holds the typed defaults set with RegisterDefault
+type defaultsRegistry struct {
+	mu     sync.RWMutex
+	values map[defaultKey]interface{}
+}
+
+func newDefaultsRegistry() *defaultsRegistry {
+	return &defaultsRegistry{values: make(map[defaultKey]interface{})}
+}
+
+// RegisterDefault registers value as the default of flag when it's evaluated
+// as a T, so callers don't have to repeat it. If the flag isn't found, the
+// evaluation resolves to value with the DEFAULT reason and
+// "registeredDefault" metadata rather than to the caller's default with a
+// FLAG_NOT_FOUND error. T is the type of the evaluation: bool, string,
+// float64, int64, or interface{} for objects, which also serves
+// ObjectEvaluationJSON and DecodeObject. Registered defaults are shared
+// with clones, and it's safe to call concurrently with evaluations.
+func RegisterDefault[T any](p *Provider, flag string, value T) {
+	key := defaultKey{flag: flag, typ: reflect.TypeFor[T]()}
+	p.defaults.mu.Lock()
+	defer p.defaults.mu.Unlock()
+	p.defaults.values[key] = value
+}
+
+// registeredDefault returns the default registered for flag as a T, if any
+func registeredDefault[T any](p *Provider, flag string) (T, bool) {
+	key := defaultKey{flag: flag, typ: reflect.TypeFor[T]()}
+	p.defaults.mu.RLock()
+	defer p.defaults.mu.RUnlock()
+	value, ok := p.defaults.values[key].(T)
+	return value, ok
+}
+
+// registeredDefaultDetail describes a missing flag resolved to its registered
+// default
+func registeredDefaultDetail() openfeature.ProviderResolutionDetail {
+	return openfeature.ProviderResolutionDetail{
+		Reason:       openfeature.DefaultReason,
+		FlagMetadata: openfeature.FlagMetadata{"registeredDefault": true},
+	}
+}
@@ -0,0 +1,73 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestRegisteredDefaultsForMissingFlags(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	ctx := context.Background()
+
+	RegisterDefault(provider, "new-checkout", true)
+	RegisterDefault(provider, "banner-text", "Welcome")
+	RegisterDefault[int64](provider, "page-size", 25)
+
+	boolResult := provider.BooleanEvaluation(ctx, "new-checkout", false, nil)
+	if !boolResult.Value || boolResult.Reason != openfeature.DefaultReason || boolResult.ResolutionDetail().ErrorCode != "" {
+		t.Errorf("Expected the registered bool default, got %v (%s, %q)", boolResult.Value, boolResult.Reason, boolResult.ResolutionDetail().ErrorCode)
+	}
+	if boolResult.FlagMetadata["registeredDefault"] != true {
+		t.Errorf("Expected registeredDefault metadata, got %v", boolResult.FlagMetadata)
+	}
+	if result := provider.StringEvaluation(ctx, "banner-text", "", nil); result.Value != "Welcome" {
+		t.Errorf("Expected the registered string default, got %q", result.Value)
+	}
+	if result := provider.IntEvaluation(ctx, "page-size", 0, nil); result.Value != 25 {
+		t.Errorf("Expected the registered int default, got %d", result.Value)
+	}
+
+	// Defaults are looked up by type: page-size has no string default
+	if result := provider.StringEvaluation(ctx, "page-size", "caller", nil); result.Value != "caller" || result.ResolutionDetail().ErrorCode != openfeature.FlagNotFoundCode {
+		t.Errorf("Expected the caller's default for another type, got %q (%q)", result.Value, result.ResolutionDetail().ErrorCode)
+	}
+
+	// Flags without a registered default keep the caller's default
+	if result := provider.BooleanEvaluation(ctx, "unregistered", true, nil); !result.Value || result.ResolutionDetail().ErrorCode != openfeature.FlagNotFoundCode {
+		t.Errorf("Expected the caller's default with FLAG_NOT_FOUND, got %v (%q)", result.Value, result.ResolutionDetail().ErrorCode)
+	}
+
+	// Flags that exist aren't affected
+	RegisterDefault(provider, "bool-flag", false)
+	if result := provider.BooleanEvaluation(ctx, "bool-flag", false, nil); !result.Value {
+		t.Error("Expected an existing flag to resolve to its own value")
+	}
+}
+
+func TestRegisteredObjectDefaultForJSONAndDecode(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	ctx := context.Background()
+
+	RegisterDefault[interface{}](provider, "theme", map[string]interface{}{"color": "blue"})
+
+	result := provider.ObjectEvaluationJSON(ctx, "theme", json.RawMessage(`{}`), nil)
+	if string(result.Value) != `{"color":"blue"}` || result.FlagMetadata["registeredDefault"] != true {
+		t.Errorf("Expected the registered default as JSON, got %s (%v)", result.Value, result.FlagMetadata)
+	}
+	if result.ResolutionDetail().ErrorCode != "" {
+		t.Errorf("Expected no error for a registered default, got %s", result.ResolutionDetail().ErrorCode)
+	}
+
+	type theme struct {
+		Color string `json:"color"`
+	}
+	decoded := DecodeObject(provider, ctx, "theme", theme{Color: "caller"}, nil)
+	if decoded.Value.Color != "blue" || decoded.FlagMetadata["registeredDefault"] != true {
+		t.Errorf("Expected the registered default to be decoded, got %+v (%v)", decoded.Value, decoded.FlagMetadata)
+	}
+}
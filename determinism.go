@@ -0,0 +1,39 @@
+package growthbook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// AssertDeterministic evaluates flag with evalCtx the given number of times
+// and returns an error if any evaluation's value, variant or reason differs
+// from the first, or if the flag can't be resolved. GrowthBook evaluations
+// are deterministic for a fixed context, so a difference points at a race on
+// shared state or at a non-deterministic transform or attribute resolver.
+//
+// The evaluation cache is bypassed and no exposures are reported, so it's
+// safe to call against a production provider, e.g. from a health check.
+func (p *Provider) AssertDeterministic(ctx context.Context, flag string, evalCtx openfeature.FlattenedContext, iterations int) error {
+	if iterations < 2 {
+		return fmt.Errorf("at least 2 iterations are needed to compare evaluations, got %d", iterations)
+	}
+	ctx = withoutTracking(ctx)
+
+	first, firstDetail, _ := resolveTypedValue(p, ctx, flag, nil, p.resolveAttributes(ctx, evalCtx), toObject, "an object")
+	if err := ResolutionErr(firstDetail); err != nil {
+		return fmt.Errorf("failed to evaluate flag '%s': %w", flag, err)
+	}
+	for i := 1; i < iterations; i++ {
+		value, detail, _ := resolveTypedValue(p, ctx, flag, nil, p.resolveAttributes(ctx, evalCtx), toObject, "an object")
+		if err := ResolutionErr(detail); err != nil {
+			return fmt.Errorf("failed to evaluate flag '%s' on evaluation %d: %w", flag, i+1, err)
+		}
+		if !sameJSONValue(value, first) || detail.Variant != firstDetail.Variant || detail.Reason != firstDetail.Reason {
+			return fmt.Errorf("flag '%s' isn't deterministic: evaluation %d returned %v (variant %q, reason %s), evaluation 1 returned %v (variant %q, reason %s)",
+				flag, i+1, value, detail.Variant, detail.Reason, first, firstDetail.Variant, firstDetail.Reason)
+		}
+	}
+	return nil
+}
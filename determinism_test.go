@@ -0,0 +1,76 @@
+package growthbook
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestAssertDeterministicStaticFlag(t *testing.T) {
+	provider := setupTestProvider(WithEvaluationCache(time.Minute))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	evalCtx := openfeature.FlattenedContext{"email": "user@growthbook.com"}
+	for _, flag := range []string{"bool-flag", "object-flag", "rules-test"} {
+		if err := provider.AssertDeterministic(context.Background(), flag, evalCtx, 50); err != nil {
+			t.Errorf("Expected %s to be deterministic, got %v", flag, err)
+		}
+	}
+}
+
+func TestAssertDeterministicDetectsChangingValues(t *testing.T) {
+	// A transform with hidden state stands in for a race on shared state
+	var calls atomic.Int64
+	provider := setupTestProvider(WithValueTransform("int-flag", func(value interface{}) interface{} {
+		if calls.Add(1) == 3 {
+			return int64(0)
+		}
+		return value
+	}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	err := provider.AssertDeterministic(context.Background(), "int-flag", nil, 5)
+	if err == nil || !strings.Contains(err.Error(), "evaluation 3 returned 0") {
+		t.Errorf("Expected the third evaluation to be reported, got %v", err)
+	}
+}
+
+func TestAssertDeterministicErrors(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	if err := provider.AssertDeterministic(context.Background(), "missing-flag", nil, 5); !errors.Is(err, ErrFlagNotFound) {
+		t.Errorf("Expected ErrFlagNotFound, got %v", err)
+	}
+	if err := provider.AssertDeterministic(context.Background(), "bool-flag", nil, 1); err == nil {
+		t.Error("Expected an error for a single iteration")
+	}
+}
+
+func TestAssertDeterministicConcurrently(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	// Concurrent evaluations with different contexts must not see each
+	// other's attributes on the shared client
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		evalCtx := openfeature.FlattenedContext{"email": "other@example.com"}
+		if i%2 == 0 {
+			evalCtx["email"] = "user@growthbook.com"
+		}
+		go func() {
+			errs <- provider.AssertDeterministic(context.Background(), "rules-test", evalCtx, 100)
+		}()
+	}
+	for i := 0; i < 8; i++ {
+		if err := <-errs; err != nil {
+			t.Error(err)
+		}
+	}
+}
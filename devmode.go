@@ -0,0 +1,58 @@
+package growthbook
+
+import (
+	"context"
+
+	gb "github.com/growthbook/growthbook-golang"
+)
+
+// devOverrideSource is the source of results forced with WithDevOverrides
+const devOverrideSource gb.FeatureResultSource = "devOverride"
+
+// devURLKey is the context key of the URL set with DevURL
+type devURLKey struct{}
+
+// DevURL returns a copy of ctx carrying the URL of the request being served.
+// In dev mode, GrowthBook's query string overrides in that URL (e.g.
+// "?my-experiment=1") force experiment variations for evaluations made with
+// ctx. Outside dev mode the URL is ignored.
+func DevURL(ctx context.Context, rawURL string) context.Context {
+	return context.WithValue(ctx, devURLKey{}, rawURL)
+}
+
+// devOverride returns the result of a flag forced with WithDevOverrides, or
+// nil if dev mode is off or the flag isn't overridden
+func (p *Provider) devOverride(flag string) *gb.FeatureResult {
+	if !p.devMode {
+		return nil
+	}
+	value, ok := p.devOverrides[flag]
+	if !ok {
+		return nil
+	}
+	on := truthy(value)
+	return &gb.FeatureResult{
+		Value:  value,
+		Source: devOverrideSource,
+		On:     on,
+		Off:    !on,
+	}
+}
+
+// withDevURL returns a child of client honoring the query string overrides of
+// the URL in ctx, if dev mode is on
+func (p *Provider) withDevURL(ctx context.Context, client *gb.Client) *gb.Client {
+	if !p.devMode {
+		return client
+	}
+	rawURL, ok := ctx.Value(devURLKey{}).(string)
+	if !ok || rawURL == "" {
+		return client
+	}
+	child, err := client.WithUrl(rawURL)
+	if err != nil {
+		p.log().Warn("Ignoring invalid dev mode URL", "url", rawURL, "error", err)
+		return client
+	}
+	return child
+}
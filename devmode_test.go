@@ -0,0 +1,63 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+const devModeFeatures = `{
+	"banner-text": {"defaultValue": "from dashboard"},
+	"checkout": {
+		"defaultValue": "none",
+		"rules": [{"key": "checkout-exp", "variations": ["control", "treatment"]}]
+	}
+}`
+
+func TestDevOverrideWinsOverDashboard(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(devModeFeatures))
+	provider := NewProvider(gbClient, false,
+		WithDevMode(true),
+		WithDevOverrides(map[string]interface{}{"banner-text": "from QA"}),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.StringEvaluation(context.Background(), "banner-text", "default", nil)
+	if result.Value != "from QA" {
+		t.Errorf("Expected dev override 'from QA', got %q", result.Value)
+	}
+	if result.FlagMetadata["devOverride"] != true {
+		t.Errorf("Expected devOverride metadata, got %v", result.FlagMetadata)
+	}
+}
+
+func TestDevOverridesIgnoredOutsideDevMode(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(devModeFeatures))
+	provider := NewProvider(gbClient, false, WithDevOverrides(map[string]interface{}{"banner-text": "from QA"}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.StringEvaluation(context.Background(), "banner-text", "default", nil)
+	if result.Value != "from dashboard" {
+		t.Errorf("Expected dashboard value outside dev mode, got %q", result.Value)
+	}
+	if _, ok := result.FlagMetadata["devOverride"]; ok {
+		t.Errorf("Expected no devOverride metadata, got %v", result.FlagMetadata)
+	}
+}
+
+func TestDevURLForcesVariation(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(devModeFeatures))
+	provider := NewProvider(gbClient, false, WithDevMode(true))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	ctx := DevURL(context.Background(), "https://example.com/checkout?checkout-exp=1")
+	result := provider.StringEvaluation(ctx, "checkout", "default", nil)
+	if result.Value != "treatment" {
+		t.Errorf("Expected the URL to force 'treatment', got %q", result.Value)
+	}
+	if result.FlagMetadata["forcedVariation"] != true {
+		t.Errorf("Expected forcedVariation metadata, got %v", result.FlagMetadata)
+	}
+}
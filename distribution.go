@@ -0,0 +1,52 @@
+package growthbook
+
+import (
+	"context"
+	"fmt"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// VariationDistribution evaluates flag for each of ids, used as the targeting
+// key, and counts how often each variation was chosen, for example to check
+// an experiment's weights over a sample of users. Users assigned a variation
+// by an experiment are counted under its variation key, which is the index of
+// the variation unless the experiment names it; others are counted under the
+// result's variant, which is empty for the flag's default value.
+//
+// No exposures are reported and the evaluation cache is bypassed. An error is
+// returned if the flag can't be resolved.
+func (p *Provider) VariationDistribution(ctx context.Context, flag string, ids []string) (map[string]int, error) {
+	ctx = withoutTracking(ctx)
+	distribution := make(map[string]int)
+	for _, id := range ids {
+		feature, errDetail := p.resolveFlag(ctx, flag, p.resolveAttributes(ctx, openfeature.FlattenedContext{openfeature.TargetingKey: id}))
+		if errDetail != nil {
+			if err := ResolutionErr(*errDetail); err != nil {
+				return nil, fmt.Errorf("failed to evaluate flag '%s' for '%s': %w", flag, id, err)
+			}
+			distribution[errDetail.Variant]++
+			continue
+		}
+		if feature.ExperimentResult != nil && feature.ExperimentResult.InExperiment {
+			distribution[variationKey(feature)]++
+			continue
+		}
+		distribution[p.createResolutionDetail(feature).Variant]++
+	}
+	return distribution, nil
+}
+
+// variationKey returns the key of the variation an experiment assigned.
+// GrowthBook ignores the meta key of an experiment's first variation and uses
+// its index, so the key is looked up in the meta first.
+func variationKey(feature *gb.FeatureResult) string {
+	result := feature.ExperimentResult
+	if exp := feature.Experiment; exp != nil && result.VariationId >= 0 && result.VariationId < len(exp.Meta) {
+		if key := exp.Meta[result.VariationId].Key; key != "" {
+			return key
+		}
+	}
+	return result.Key
+}
@@ -0,0 +1,56 @@
+package growthbook
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestVariationDistribution(t *testing.T) {
+	featuresJSON := `{
+		"checkout": {
+			"defaultValue": "control",
+			"rules": [{
+				"id": "checkout-rule",
+				"key": "checkout-test",
+				"variations": ["control", "treatment"],
+				"weights": [0.5, 0.5],
+				"coverage": 1,
+				"meta": [{"key": "ctl"}, {"key": "trt"}]
+			}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	tracked := 0
+	provider := NewProvider(gbClient, false, WithTrackingCallback(func(ctx context.Context, exp *gb.Experiment, result *gb.ExperimentResult) {
+		tracked++
+	}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	ids := make([]string, 2000)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("user-%d", i)
+	}
+	distribution, err := provider.VariationDistribution(context.Background(), "checkout", ids)
+	if err != nil {
+		t.Fatalf("Expected the distribution, got %v", err)
+	}
+	if distribution["ctl"]+distribution["trt"] != len(ids) {
+		t.Fatalf("Expected every id to be counted under a variation, got %v", distribution)
+	}
+	for _, variation := range []string{"ctl", "trt"} {
+		if share := float64(distribution[variation]) / float64(len(ids)); share < 0.45 || share > 0.55 {
+			t.Errorf("Expected about half of the ids in %s, got %.2f (%v)", variation, share, distribution)
+		}
+	}
+	if tracked != 0 {
+		t.Errorf("Expected no exposures, got %d", tracked)
+	}
+
+	if _, err := provider.VariationDistribution(context.Background(), "missing-flag", ids); err == nil {
+		t.Error("Expected an error for a missing flag")
+	}
+}
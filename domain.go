@@ -0,0 +1,22 @@
+package growthbook
+
+import "context"
+
+// domainKey is the context key holding the domain passed to tracking callbacks
+type domainKey struct{}
+
+// DomainFromContext returns the OpenFeature domain set with WithDomainName on
+// the provider that reported an exposure. It's meant to be called in a
+// TrackingCallback, whose context carries the domain when one is set.
+func DomainFromContext(ctx context.Context) (string, bool) {
+	domain, ok := ctx.Value(domainKey{}).(string)
+	return domain, ok
+}
+
+// withDomain adds the provider's domain, if any, to ctx
+func (p *Provider) withDomain(ctx context.Context) context.Context {
+	if p.domain == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, domainKey{}, p.domain)
+}
@@ -0,0 +1,50 @@
+package growthbook
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestDomainNameInMetadataAndRecords(t *testing.T) {
+	var buf bytes.Buffer
+	var trackedDomain string
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"exp-flag": {"defaultValue": "none", "rules": [{"key": "exp", "variations": ["control", "treatment"], "coverage": 1}]}
+	}`))
+	provider := NewProvider(gbClient, false,
+		WithDomainName("checkout"),
+		WithAuditLog(&buf),
+		WithTrackingCallback(func(ctx context.Context, _ *gb.Experiment, _ *gb.ExperimentResult) {
+			trackedDomain, _ = DomainFromContext(ctx)
+		}),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	if name := provider.Metadata().Name; name != "GrowthBook Provider (checkout)" {
+		t.Errorf("Expected the domain in the metadata name, got %q", name)
+	}
+
+	provider.StringEvaluation(context.Background(), "exp-flag", "default", openfeature.FlattenedContext{"id": "user-1"})
+	if trackedDomain != "checkout" {
+		t.Errorf("Expected the tracking callback to get the domain, got %q", trackedDomain)
+	}
+	lines := auditLines(t, &buf)
+	if len(lines) != 1 || lines[0]["domain"] != "checkout" {
+		t.Errorf("Expected the domain in the audit line, got %v", lines)
+	}
+}
+
+func TestNoDomainName(t *testing.T) {
+	provider := setupTestProvider()
+
+	if name := provider.Metadata().Name; name != "GrowthBook Provider" {
+		t.Errorf("Expected the plain metadata name, got %q", name)
+	}
+	if _, ok := DomainFromContext(provider.withDomain(context.Background())); ok {
+		t.Error("Expected no domain in the context")
+	}
+}
@@ -0,0 +1,31 @@
+package growthbook
+
+import (
+	"context"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// dryRunKey is the context key marking dry-run evaluations
+type dryRunKey struct{}
+
+// DryRun returns a copy of ctx for dry-run evaluations, which resolve flags
+// as usual but don't report experiment exposures to the tracking callback, so
+// pre-computing flags for analytics or debugging doesn't pollute experiment
+// data. Their results carry "dryRun" metadata. Dry runs bypass the evaluation
+// cache, so a later evaluation still reports its exposure.
+func DryRun(ctx context.Context) context.Context {
+	return context.WithValue(withoutTracking(ctx), dryRunKey{}, true)
+}
+
+// isDryRun reports whether ctx is marked with DryRun
+func isDryRun(ctx context.Context) bool {
+	return ctx.Value(dryRunKey{}) != nil
+}
+
+// markDryRun adds the "dryRun" metadata to the result of a dry run
+func markDryRun(ctx context.Context, detail *openfeature.ProviderResolutionDetail) {
+	if isDryRun(ctx) {
+		*detail = withMetadata(*detail, "dryRun", true)
+	}
+}
@@ -0,0 +1,50 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestDryRunSkipsTracking(t *testing.T) {
+	featuresJSON := `{
+		"exp-flag": {
+			"defaultValue": "control",
+			"rules": [{"key": "exp-flag-test", "variations": ["control", "treatment"], "coverage": 1}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+
+	tracked := 0
+	provider := NewProvider(gbClient, false,
+		WithEvaluationCache(time.Minute),
+		WithTrackingCallback(func(ctx context.Context, exp *gb.Experiment, result *gb.ExperimentResult) {
+			tracked++
+		}),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	evalCtx := openfeature.FlattenedContext{"id": "user-1"}
+
+	result := provider.StringEvaluation(DryRun(context.Background()), "exp-flag", "none", evalCtx)
+	if result.Value != "control" && result.Value != "treatment" {
+		t.Errorf("Expected a variation value, got %q (error: %v)", result.Value, result.Error())
+	}
+	if tracked != 0 {
+		t.Errorf("Expected no tracking during a dry run, got %d calls", tracked)
+	}
+	if result.FlagMetadata["dryRun"] != true {
+		t.Errorf("Expected dryRun metadata, got %v", result.FlagMetadata)
+	}
+
+	// A dry run doesn't fill the cache, so the normal evaluation still reports its exposure
+	result = provider.StringEvaluation(context.Background(), "exp-flag", "none", evalCtx)
+	if tracked != 1 {
+		t.Errorf("Expected tracking for a normal evaluation, got %d calls", tracked)
+	}
+	if _, ok := result.FlagMetadata["dryRun"]; ok {
+		t.Errorf("Expected no dryRun metadata on a normal evaluation, got %v", result.FlagMetadata)
+	}
+}
@@ -0,0 +1,57 @@
+package growthbook
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// Sentinel errors for the resolution failures callers most commonly branch on.
+// Use them with errors.Is on the error returned by ResolutionErr.
+var (
+	ErrProviderNotReady = errors.New("provider not ready")
+	ErrFlagNotFound     = errors.New("flag not found")
+	ErrTypeMismatch     = errors.New("type mismatch")
+)
+
+// codeErrors maps OpenFeature error codes to the sentinel errors above
+var codeErrors = map[openfeature.ErrorCode]error{
+	openfeature.ProviderNotReadyCode: ErrProviderNotReady,
+	openfeature.FlagNotFoundCode:     ErrFlagNotFound,
+	openfeature.TypeMismatchCode:     ErrTypeMismatch,
+}
+
+// ResolutionErr converts the resolution error of a detail into a Go error, or
+// returns nil if the flag resolved successfully. The error wraps the matching
+// sentinel error so callers can use errors.Is instead of string matching:
+//
+//	result := provider.BooleanEvaluation(ctx, "flag", false, evalCtx)
+//	if errors.Is(growthbook.ResolutionErr(result.ProviderResolutionDetail), growthbook.ErrFlagNotFound) {
+//		// ...
+//	}
+//
+// openfeature.ResolutionError can only be built through the SDK constructors
+// and can't wrap other errors, which is why this conversion is needed. The
+// errors returned by an openfeature.Client don't wrap the sentinels either;
+// convert the client's evaluation details with DetailErr instead.
+func ResolutionErr(detail openfeature.ProviderResolutionDetail) error {
+	return DetailErr(detail.ResolutionDetail())
+}
+
+// DetailErr is ResolutionErr for the evaluation details returned by an
+// openfeature.Client:
+//
+//	details, _ := client.BooleanValueDetails(ctx, "flag", false, evalCtx)
+//	if errors.Is(growthbook.DetailErr(details.ResolutionDetail), growthbook.ErrFlagNotFound) {
+//		// ...
+//	}
+func DetailErr(resolution openfeature.ResolutionDetail) error {
+	if resolution.ErrorCode == "" {
+		return nil
+	}
+	if sentinel, ok := codeErrors[resolution.ErrorCode]; ok {
+		return fmt.Errorf("%w: %s", sentinel, resolution.ErrorMessage)
+	}
+	return fmt.Errorf("%s: %s", resolution.ErrorCode, resolution.ErrorMessage)
+}
@@ -0,0 +1,85 @@
+package growthbook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestResolutionErrSentinels(t *testing.T) {
+	ready := setupTestProvider()
+	_ = ready.Init(openfeature.NewEvaluationContext("test-user", nil))
+	notReady := setupTestProvider()
+
+	tests := []struct {
+		name     string
+		detail   openfeature.ProviderResolutionDetail
+		expected error
+	}{
+		{
+			name:     "provider not ready",
+			detail:   notReady.BooleanEvaluation(context.Background(), "bool-flag", false, nil).ProviderResolutionDetail,
+			expected: ErrProviderNotReady,
+		},
+		{
+			name:     "flag not found",
+			detail:   ready.BooleanEvaluation(context.Background(), "non-existent-flag", false, nil).ProviderResolutionDetail,
+			expected: ErrFlagNotFound,
+		},
+		{
+			name:     "type mismatch",
+			detail:   ready.BooleanEvaluation(context.Background(), "string-flag", false, nil).ProviderResolutionDetail,
+			expected: ErrTypeMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ResolutionErr(tt.detail)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("Expected errors.Is(%v, %v) to be true", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestResolutionErrNilOnSuccess(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	result := provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+	if err := ResolutionErr(result.ProviderResolutionDetail); err != nil {
+		t.Errorf("Expected nil error for successful resolution, got %v", err)
+	}
+}
+
+func TestDetailErrThroughOpenFeatureClient(t *testing.T) {
+	provider := setupTestProvider()
+	if err := openfeature.SetNamedProviderAndWait("errors-test", provider); err != nil {
+		t.Fatalf("Expected provider to be registered, got %v", err)
+	}
+	defer openfeature.Shutdown()
+	client := openfeature.NewClient("errors-test")
+
+	details, err := client.BooleanValueDetails(context.Background(), "non-existent-flag", false, openfeature.EvaluationContext{})
+	if err == nil {
+		t.Fatal("Expected the client to return an error for a missing flag")
+	}
+
+	// The client's error is an openfeature.ResolutionError, which doesn't wrap the sentinels
+	if errors.Is(err, ErrFlagNotFound) {
+		t.Error("Expected the client's error not to match ErrFlagNotFound")
+	}
+
+	// The client's evaluation details do carry the error code
+	if !errors.Is(DetailErr(details.ResolutionDetail), ErrFlagNotFound) {
+		t.Errorf("Expected DetailErr to wrap ErrFlagNotFound, got %v", DetailErr(details.ResolutionDetail))
+	}
+
+	details, err = client.BooleanValueDetails(context.Background(), "bool-flag", false, openfeature.EvaluationContext{})
+	if err != nil || DetailErr(details.ResolutionDetail) != nil {
+		t.Errorf("Expected no error for a resolved flag, got %v and %v", err, DetailErr(details.ResolutionDetail))
+	}
+}
@@ -0,0 +1,72 @@
+package growthbook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// The Evaluate methods are conveniences for using the provider directly
+// rather than through an openfeature.Client. They take an
+// openfeature.EvaluationContext and flatten it the way the OpenFeature SDK
+// does, so they resolve flags exactly like the client would.
+
+// EvaluateBool evaluates a boolean feature flag with an evaluation context.
+func (p *Provider) EvaluateBool(ctx context.Context, flag string, defaultValue bool, evalCtx openfeature.EvaluationContext) openfeature.BoolResolutionDetail {
+	return p.BooleanEvaluation(ctx, flag, defaultValue, flatten(evalCtx))
+}
+
+// EvaluateString evaluates a string feature flag with an evaluation context.
+func (p *Provider) EvaluateString(ctx context.Context, flag string, defaultValue string, evalCtx openfeature.EvaluationContext) openfeature.StringResolutionDetail {
+	return p.StringEvaluation(ctx, flag, defaultValue, flatten(evalCtx))
+}
+
+// EvaluateFloat evaluates a float feature flag with an evaluation context.
+func (p *Provider) EvaluateFloat(ctx context.Context, flag string, defaultValue float64, evalCtx openfeature.EvaluationContext) openfeature.FloatResolutionDetail {
+	return p.FloatEvaluation(ctx, flag, defaultValue, flatten(evalCtx))
+}
+
+// EvaluateInt evaluates an integer feature flag with an evaluation context.
+func (p *Provider) EvaluateInt(ctx context.Context, flag string, defaultValue int64, evalCtx openfeature.EvaluationContext) openfeature.IntResolutionDetail {
+	return p.IntEvaluation(ctx, flag, defaultValue, flatten(evalCtx))
+}
+
+// EvaluateObject evaluates an object feature flag with an evaluation context.
+func (p *Provider) EvaluateObject(ctx context.Context, flag string, defaultValue interface{}, evalCtx openfeature.EvaluationContext) openfeature.InterfaceResolutionDetail {
+	return p.ObjectEvaluation(ctx, flag, defaultValue, flatten(evalCtx))
+}
+
+// EvaluateAllFlags evaluates every flag known to the client with an
+// evaluation context, keyed by flag. A flag that fails to resolve doesn't
+// abort the batch: its detail carries the error and a nil value, the other
+// flags are still returned, and the returned error joins the errors of every
+// failed flag.
+func (p *Provider) EvaluateAllFlags(ctx context.Context, evalCtx openfeature.EvaluationContext) (map[string]openfeature.InterfaceResolutionDetail, error) {
+	flat := flatten(evalCtx)
+	features := p.gbClient.Features()
+	results := make(map[string]openfeature.InterfaceResolutionDetail, len(features))
+	var errs []error
+	for flag := range features {
+		result := p.ObjectEvaluation(ctx, flag, nil, flat)
+		if err := result.Error(); err != nil {
+			errs = append(errs, fmt.Errorf("flag %q: %w", flag, err))
+		}
+		results[flag] = result
+	}
+	return results, errors.Join(errs...)
+}
+
+// flatten converts an evaluation context into the flattened form providers
+// receive, adding the targeting key under openfeature.TargetingKey if set
+func flatten(evalCtx openfeature.EvaluationContext) openfeature.FlattenedContext {
+	flat := openfeature.FlattenedContext{}
+	for k, v := range evalCtx.Attributes() {
+		flat[k] = v
+	}
+	if key := evalCtx.TargetingKey(); key != "" {
+		flat[openfeature.TargetingKey] = key
+	}
+	return flat
+}
@@ -0,0 +1,84 @@
+package growthbook
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestEvaluateWithEvaluationContext(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+	ctx := context.Background()
+	evalCtx := openfeature.NewEvaluationContext("user-1", map[string]interface{}{"email": "user@growthbook.com"})
+
+	if result := provider.EvaluateBool(ctx, "rules-test", false, evalCtx); !result.Value {
+		t.Error("Expected the context's attributes to match the rule")
+	}
+	if result := provider.EvaluateString(ctx, "string-flag", "default", evalCtx); result.Value != "default-string" {
+		t.Errorf("Expected 'default-string', got %q", result.Value)
+	}
+	if result := provider.EvaluateFloat(ctx, "number-flag", 0, evalCtx); result.Value != 42.5 {
+		t.Errorf("Expected 42.5, got %v", result.Value)
+	}
+	if result := provider.EvaluateInt(ctx, "int-flag", 0, evalCtx); result.Value != 42 {
+		t.Errorf("Expected 42, got %d", result.Value)
+	}
+	result := provider.EvaluateObject(ctx, "object-flag", nil, evalCtx)
+	if object, ok := result.Value.(map[string]interface{}); !ok || object["key"] != "value" {
+		t.Errorf("Expected object with key 'value', got %v", result.Value)
+	}
+}
+
+func TestEvaluateFlattensTargetingKey(t *testing.T) {
+	featuresJSON := `{
+		"beta": {
+			"defaultValue": false,
+			"rules": [{"condition": {"targetingKey": "user-1"}, "force": true}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.EvaluateBool(context.Background(), "beta", false, openfeature.NewEvaluationContext("user-1", nil))
+	if !result.Value {
+		t.Error("Expected the targeting key to be passed as the targetingKey attribute")
+	}
+	result = provider.EvaluateBool(context.Background(), "beta", false, openfeature.NewTargetlessEvaluationContext(nil))
+	if result.Value {
+		t.Error("Expected no targetingKey attribute for a targetless context")
+	}
+}
+
+func TestEvaluateAllFlagsReturnsPartialResults(t *testing.T) {
+	featuresJSON := `{
+		"banner": {"defaultValue": "hello"},
+		"beta": {"defaultValue": true},
+		"huge-config": {"defaultValue": {"blob": "` + strings.Repeat("x", 100) + `"}}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	// The oversized object is rejected, which breaks that flag alone
+	provider := NewProvider(gbClient, false, WithMaxObjectSize(50), WithRejectOversizedObjects(true))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	results, err := provider.EvaluateAllFlags(context.Background(), openfeature.NewEvaluationContext("user-1", nil))
+	if err == nil || !strings.Contains(err.Error(), `"huge-config"`) {
+		t.Errorf("Expected an aggregate error naming the broken flag, got %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("Expected results for all 3 flags, got %d", len(results))
+	}
+	if results["banner"].Value != "hello" || results["banner"].Error() != nil {
+		t.Errorf("Expected banner to resolve, got %v (%v)", results["banner"].Value, results["banner"].Error())
+	}
+	if results["beta"].Value != true {
+		t.Errorf("Expected beta to resolve, got %v", results["beta"].Value)
+	}
+	if code := results["huge-config"].ResolutionDetail().ErrorCode; code != openfeature.ParseErrorCode {
+		t.Errorf("Expected a ParseError for the broken flag, got %q", code)
+	}
+}
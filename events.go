@@ -0,0 +1,189 @@
+package growthbook
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// eventBufferSize is the number of events buffered for the OpenFeature SDK
+const eventBufferSize = 16
+
+// EventChannel implements openfeature.EventHandler. The OpenFeature SDK tracks
+// provider state changes after Init through these events.
+func (p *Provider) EventChannel() <-chan openfeature.Event {
+	return p.events
+}
+
+// emit sends an event without blocking. Events are dropped if nobody consumes
+// the channel and its buffer is full.
+func (p *Provider) emit(eventType openfeature.EventType, message string) {
+	p.emitDetails(eventType, openfeature.ProviderEventDetails{Message: message})
+}
+
+// emitDetails is emit for events carrying more than a message
+func (p *Provider) emitDetails(eventType openfeature.EventType, details openfeature.ProviderEventDetails) {
+	event := openfeature.Event{
+		ProviderName:         p.Metadata().Name,
+		EventType:            eventType,
+		ProviderEventDetails: details,
+	}
+	select {
+	case p.events <- event:
+	default:
+	}
+	p.eventSubs.publish(event)
+}
+
+// eventSubscribers holds the channels returned by Subscribe
+type eventSubscribers struct {
+	mu    sync.Mutex
+	next  int
+	chans map[int]chan openfeature.Event
+}
+
+// publish sends event to every subscriber without blocking
+func (s *eventSubscribers) publish(event openfeature.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, ch := range s.chans {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel receiving the provider's events, for consumers
+// other than the OpenFeature SDK, which uses EventChannel. Unlike the SDK,
+// subscribers can't tell Init's outcome, so they also receive PROVIDER_READY
+// when Init succeeds. A subscriber joining once the provider is ready receives
+// a PROVIDER_READY straight away, with "replayed" event metadata, so it isn't
+// left waiting for an event that was sent before it subscribed.
+//
+// Events are dropped if the channel's buffer is full. unsubscribe closes the
+// channel.
+func (p *Provider) Subscribe() (events <-chan openfeature.Event, unsubscribe func()) {
+	ch := make(chan openfeature.Event, eventBufferSize)
+
+	s := &p.eventSubs
+	s.mu.Lock()
+	// The state is read with the subscriber lock held, so events for later
+	// transitions are published to the new channel
+	if p.Status() == openfeature.ReadyState {
+		ch <- openfeature.Event{
+			ProviderName: p.Metadata().Name,
+			EventType:    openfeature.ProviderReady,
+			ProviderEventDetails: openfeature.ProviderEventDetails{
+				Message:       "GrowthBook provider is ready",
+				EventMetadata: map[string]interface{}{"replayed": true},
+			},
+		}
+	}
+	if s.chans == nil {
+		s.chans = map[int]chan openfeature.Event{}
+	}
+	id := s.next
+	s.next++
+	s.chans[id] = ch
+	s.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			delete(s.chans, id)
+			close(ch)
+		})
+	}
+}
+
+// startWatching starts the goroutines emitting configuration change events
+// and health logs, if WithChangeEvents or WithHealthLogInterval is enabled and
+// they aren't running yet. It must be called with stateMutex held.
+func (p *Provider) startWatching() {
+	if (p.changeInterval <= 0 && p.healthInterval <= 0) || p.watchDone != nil {
+		return
+	}
+	done := make(chan struct{})
+	p.watchDone = done
+	if p.changeInterval > 0 {
+		// Changes are reported relative to the features loaded by Init
+		current := p.gbClient.Features()
+		p.watchWG.Add(1)
+		go func() {
+			defer p.watchWG.Done()
+			p.watchFeatures(current, done)
+		}()
+	}
+	if p.healthInterval > 0 {
+		p.watchWG.Add(1)
+		go func() {
+			defer p.watchWG.Done()
+			p.logHealth(done)
+		}()
+	}
+}
+
+// stopWatching signals the goroutines started by startWatching to exit and
+// waits for them. It must be called without stateMutex held.
+func (p *Provider) stopWatching() {
+	p.stateMutex.Lock()
+	done := p.watchDone
+	p.watchDone = nil
+	p.stateMutex.Unlock()
+
+	if done != nil {
+		close(done)
+		p.watchWG.Wait()
+	}
+}
+
+// watchFeatures emits PROVIDER_CONFIGURATION_CHANGED, listing the changed
+// flags, whenever the client's features change from current, until done is
+// closed. Flags whose value type changed are logged and listed under the
+// event's "typeChanged" metadata.
+func (p *Provider) watchFeatures(current gb.FeatureMap, done <-chan struct{}) {
+	ticker := time.NewTicker(p.changeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		features := p.gbClient.Features()
+		if reflect.ValueOf(features).Pointer() == reflect.ValueOf(current).Pointer() {
+			// The client replaces its feature map on every update
+			continue
+		}
+		diffs := diffFeatures(current, features)
+		current = features
+		if len(diffs) == 0 {
+			continue
+		}
+		details := openfeature.ProviderEventDetails{
+			Message:     "GrowthBook features were updated",
+			FlagChanges: changedFlags(diffs),
+		}
+		if typeChanged := p.warnTypeChanges(diffs); len(typeChanged) > 0 {
+			details.EventMetadata = map[string]interface{}{"typeChanged": typeChanged}
+		}
+		p.emitDetails(openfeature.ProviderConfigChange, details)
+	}
+}
+
+// changedFlags returns the keys of the flags in diffs
+func changedFlags(diffs []FlagDiff) []string {
+	var changed []string
+	for _, diff := range diffs {
+		changed = append(changed, diff.Flag)
+	}
+	return changed
+}
@@ -0,0 +1,137 @@
+package growthbook
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestChangeEventsListChangedFlags(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"kept": {"defaultValue": 1},
+		"modified": {"defaultValue": "before"},
+		"removed": {"defaultValue": true}
+	}`))
+	provider := NewProvider(gbClient, false, WithChangeEvents(10*time.Millisecond))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	defer provider.Shutdown()
+
+	_ = gbClient.SetJSONFeatures(`{
+		"kept": {"defaultValue": 1},
+		"modified": {"defaultValue": "after"},
+		"added": {"defaultValue": false}
+	}`)
+
+	select {
+	case event := <-provider.EventChannel():
+		if event.EventType != openfeature.ProviderConfigChange {
+			t.Fatalf("Expected %s event, got %s", openfeature.ProviderConfigChange, event.EventType)
+		}
+		expected := []string{"added", "modified", "removed"}
+		if !reflect.DeepEqual(event.FlagChanges, expected) {
+			t.Errorf("Expected changed flags %v, got %v", expected, event.FlagChanges)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for a configuration change event")
+	}
+}
+
+func TestChangeEventsIgnoreIdenticalReload(t *testing.T) {
+	featuresJSON := `{"flag": {"defaultValue": 1}}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, false, WithChangeEvents(10*time.Millisecond))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	defer provider.Shutdown()
+
+	_ = gbClient.SetJSONFeatures(featuresJSON)
+
+	select {
+	case event := <-provider.EventChannel():
+		t.Errorf("Expected no event for unchanged features, got %s", event.EventType)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestShutdownStopsChangeEventGoroutine(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	for i := 0; i < 5; i++ {
+		gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{}`))
+		provider := NewProvider(gbClient, false, WithChangeEvents(time.Millisecond))
+		_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+		provider.Shutdown()
+	}
+
+	// Goroutines of other tests may still be winding down
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("Expected no goroutines to remain after shutdown, had %d before and %d after", before, after)
+	}
+}
+
+// nextEvent waits for the next event on events
+func nextEvent(t *testing.T, events <-chan openfeature.Event) openfeature.Event {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for an event")
+		return openfeature.Event{}
+	}
+}
+
+func TestSubscribeAfterInitReceivesReady(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{"banner": {"defaultValue": "v1"}}`))
+	provider := NewProvider(gbClient, false, WithChangeEvents(10*time.Millisecond))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	defer provider.Shutdown()
+
+	events, unsubscribe := provider.Subscribe()
+	defer unsubscribe()
+
+	ready := nextEvent(t, events)
+	if ready.EventType != openfeature.ProviderReady {
+		t.Fatalf("Expected a PROVIDER_READY for a late subscriber, got %s", ready.EventType)
+	}
+	if ready.EventMetadata["replayed"] != true {
+		t.Errorf("Expected the ready event to be marked as replayed, got %v", ready.EventMetadata)
+	}
+
+	// Later events are published to the subscriber too
+	_ = gbClient.SetJSONFeatures(`{"banner": {"defaultValue": "v2"}}`)
+	if changed := nextEvent(t, events); changed.EventType != openfeature.ProviderConfigChange {
+		t.Errorf("Expected a PROVIDER_CONFIGURATION_CHANGED, got %s", changed.EventType)
+	}
+}
+
+func TestSubscribeBeforeInitReceivesReady(t *testing.T) {
+	provider := setupTestProvider()
+
+	events, unsubscribe := provider.Subscribe()
+	select {
+	case event := <-events:
+		t.Fatalf("Expected no event before Init, got %s", event.EventType)
+	default:
+	}
+
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	ready := nextEvent(t, events)
+	if ready.EventType != openfeature.ProviderReady || ready.EventMetadata["replayed"] != nil {
+		t.Errorf("Expected Init's PROVIDER_READY, got %s (%v)", ready.EventType, ready.EventMetadata)
+	}
+
+	unsubscribe()
+	if _, ok := <-events; ok {
+		t.Error("Expected unsubscribe to close the channel")
+	}
+	unsubscribe()
+}
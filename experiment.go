@@ -0,0 +1,98 @@
+package growthbook
+
+import (
+	"context"
+	"fmt"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// TrackingCallback is invoked when a user is included in an experiment. ctx
+// is the context the evaluation was made with (bounded by the evaluation
+// timeout, if any), so request-scoped values such as trace IDs can be used to
+// correlate exposures with requests.
+type TrackingCallback func(ctx context.Context, experiment *gb.Experiment, result *gb.ExperimentResult)
+
+// RunExperiment runs an inline experiment that isn't defined as a feature, using
+// the attributes from evalCtx for bucketing. Exposures are reported through the
+// tracking callback, just like experiments reached through feature flags.
+func (p *Provider) RunExperiment(ctx context.Context, exp gb.Experiment, evalCtx openfeature.FlattenedContext) (*gb.ExperimentResult, error) {
+	if !p.canEvaluate() {
+		return nil, fmt.Errorf("%w: cannot run experiment '%s'", ErrProviderNotReady, exp.Key)
+	}
+	if len(exp.Variations) < 2 {
+		return nil, fmt.Errorf("experiment '%s' needs at least two variations", exp.Key)
+	}
+
+	return p.clientFor(evalCtx).RunExperiment(ctx, &exp), nil
+}
+
+// VariationsOf returns the variations of the experiment behind a multivariate
+// flag, for example to render all its possible values side by side. If the
+// flag has several experiment rules, the variations of the first are returned.
+// It returns an error wrapping ErrFlagNotFound for unknown flags, and an error
+// if the flag isn't backed by an experiment.
+func (p *Provider) VariationsOf(flag string) ([]interface{}, error) {
+	feature := p.gbClient.Features()[flag]
+	if feature == nil {
+		return nil, fmt.Errorf("%w: '%s'", ErrFlagNotFound, flag)
+	}
+	for _, rule := range feature.Rules {
+		if len(rule.Variations) > 0 {
+			variations := make([]interface{}, len(rule.Variations))
+			for i, variation := range rule.Variations {
+				variations[i] = variation
+			}
+			return variations, nil
+		}
+	}
+	return nil, fmt.Errorf("flag '%s' isn't backed by an experiment", flag)
+}
+
+// ExperimentResultFor evaluates flag and returns the complete experiment
+// result behind its value, for analytics integrations that need more than the
+// flag metadata (bucket, hash value, variation id, ...). The boolean reports
+// whether the value was served by an experiment. Like any evaluation, it
+// reports an exposure through the tracking callback. It returns an error
+// wrapping one of the sentinel errors if the flag can't be resolved.
+func (p *Provider) ExperimentResultFor(ctx context.Context, flag string, evalCtx openfeature.FlattenedContext) (*gb.ExperimentResult, bool, error) {
+	feature, errDetail := p.resolveFlag(ctx, flag, p.resolveAttributes(ctx, evalCtx))
+	if errDetail != nil {
+		return nil, false, DetailErr(errDetail.ResolutionDetail())
+	}
+	if feature.Source != gb.ExperimentResultSource || feature.ExperimentResult == nil {
+		return nil, false, nil
+	}
+	return feature.ExperimentResult, true, nil
+}
+
+// maxVariationSearch bounds the ids IDForVariation tries
+const maxVariationSearch = 10000
+
+// IDForVariation returns an id that the experiment behind flag deterministically
+// assigns to the given variation when evaluated with the other attributes of
+// evalCtx, so tests can pin a user into a known variation. GrowthBook buckets
+// by hashing the rule's seed (the experiment key unless a seed is set on the
+// rule) with the hash attribute, so an id found once is assigned the same
+// variation on every run for as long as the experiment's seed, weights and
+// coverage don't change. The ids tried are "test-user-0", "test-user-1", and
+// so on. No exposures are reported while searching.
+func (p *Provider) IDForVariation(ctx context.Context, flag string, variation int, evalCtx openfeature.FlattenedContext) (string, error) {
+	if p.gbClient.Features()[flag] == nil {
+		return "", fmt.Errorf("%w: '%s'", ErrFlagNotFound, flag)
+	}
+
+	untracked, _ := p.gbClient.WithExperimentCallback(nil)
+	attrs := p.buildAttributes(p.withTimeAttribute(ctx, p.resolveAttributes(ctx, evalCtx)))
+	for i := 0; i < maxVariationSearch; i++ {
+		id := fmt.Sprintf("test-user-%d", i)
+		attrs["id"] = id
+		client, _ := untracked.WithAttributes(attrs)
+		feature := client.EvalFeature(ctx, flag)
+		if feature.Source == gb.ExperimentResultSource && feature.ExperimentResult != nil && feature.ExperimentResult.VariationId == variation {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("no id assigned to variation %d of flag '%s' found", variation, flag)
+}
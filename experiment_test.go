@@ -0,0 +1,361 @@
+package growthbook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestRunExperiment(t *testing.T) {
+	var exposures []*gb.ExperimentResult
+	provider := setupTestProvider(WithTrackingCallback(func(ctx context.Context, exp *gb.Experiment, result *gb.ExperimentResult) {
+		if exp.Key != "inline-exp" {
+			t.Errorf("Expected exposure for 'inline-exp', got %q", exp.Key)
+		}
+		exposures = append(exposures, result)
+	}))
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	exp := gb.Experiment{
+		Key:        "inline-exp",
+		Variations: []gb.FeatureValue{"control", "treatment"},
+	}
+	evalCtx := openfeature.FlattenedContext{"id": "user-123"}
+
+	result, err := provider.RunExperiment(context.Background(), exp, evalCtx)
+	if err != nil {
+		t.Fatalf("RunExperiment failed: %v", err)
+	}
+	if !result.InExperiment {
+		t.Fatal("Expected user to be included in the experiment")
+	}
+	if result.Value != exp.Variations[result.VariationId] {
+		t.Errorf("Expected value %v for variation %d, got %v", exp.Variations[result.VariationId], result.VariationId, result.Value)
+	}
+	if len(exposures) != 1 {
+		t.Fatalf("Expected 1 exposure, got %d", len(exposures))
+	}
+
+	// Assignment is stable for the same user
+	again, _ := provider.RunExperiment(context.Background(), exp, evalCtx)
+	if again.VariationId != result.VariationId {
+		t.Errorf("Expected stable assignment %d, got %d", result.VariationId, again.VariationId)
+	}
+}
+
+func TestRunExperimentForcedVariation(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	force := 1
+	exp := gb.Experiment{
+		Key:        "forced-exp",
+		Variations: []gb.FeatureValue{"control", "treatment"},
+		Force:      &force,
+	}
+
+	result, err := provider.RunExperiment(context.Background(), exp, openfeature.FlattenedContext{"id": "user-123"})
+	if err != nil {
+		t.Fatalf("RunExperiment failed: %v", err)
+	}
+	if result.VariationId != 1 || result.Value != "treatment" {
+		t.Errorf("Expected forced variation 1 'treatment', got %d %v", result.VariationId, result.Value)
+	}
+}
+
+func TestRunExperimentNotReady(t *testing.T) {
+	provider := setupTestProvider()
+
+	exp := gb.Experiment{Key: "exp", Variations: []gb.FeatureValue{0, 1}}
+	_, err := provider.RunExperiment(context.Background(), exp, nil)
+	if !errors.Is(err, ErrProviderNotReady) {
+		t.Errorf("Expected ErrProviderNotReady, got %v", err)
+	}
+}
+
+func TestTrackingCallbackOnFeatureExperiment(t *testing.T) {
+	featuresJSON := `{
+		"exp-flag": {
+			"defaultValue": "control",
+			"rules": [{"key": "exp-flag-test", "variations": ["control", "treatment"], "coverage": 1}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+
+	tracked := 0
+	provider := NewProvider(gbClient, false, WithTrackingCallback(func(ctx context.Context, exp *gb.Experiment, result *gb.ExperimentResult) {
+		tracked++
+	}))
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	provider.StringEvaluation(context.Background(), "exp-flag", "none", openfeature.FlattenedContext{"id": "user-1"})
+	if tracked != 1 {
+		t.Errorf("Expected tracking callback to fire once, got %d", tracked)
+	}
+}
+
+func TestVariantFromAnonymousExperimentRule(t *testing.T) {
+	featuresJSON := `{
+		"checkout": {
+			"defaultValue": "none",
+			"rules": [{"variations": ["control", "treatment"], "meta": [{"key": "ctl"}, {"key": "trt"}]}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, false, WithForcedVariations(map[string]int{"checkout": 1}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.StringEvaluation(context.Background(), "checkout", "fallback", openfeature.FlattenedContext{"id": "user-1"})
+	if result.Value != "treatment" {
+		t.Fatalf("Expected forced variation 'treatment', got %q", result.Value)
+	}
+	if result.Variant != "trt" {
+		t.Errorf("Expected variant from the variation key 'trt', got %q", result.Variant)
+	}
+
+	// Without variation meta the key is the variation index
+	unnamed := `{"checkout": {"defaultValue": "none", "rules": [{"variations": ["control", "treatment"]}]}}`
+	_ = gbClient.SetJSONFeatures(unnamed)
+	result = provider.StringEvaluation(context.Background(), "checkout", "fallback", openfeature.FlattenedContext{"id": "user-1"})
+	if result.Variant != "1" {
+		t.Errorf("Expected variant '1' from the variation index, got %q", result.Variant)
+	}
+}
+
+func TestVariationsOf(t *testing.T) {
+	featuresJSON := `{
+		"button-color": {
+			"defaultValue": "gray",
+			"rules": [
+				{"condition": {"plan": "pro"}, "force": "gold"},
+				{"variations": ["red", "green", "blue"]}
+			]
+		},
+		"simple-flag": {"defaultValue": true}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	variations, err := provider.VariationsOf("button-color")
+	if err != nil {
+		t.Fatalf("VariationsOf failed: %v", err)
+	}
+	expected := []interface{}{"red", "green", "blue"}
+	if !reflect.DeepEqual(variations, expected) {
+		t.Errorf("Expected variations %v, got %v", expected, variations)
+	}
+
+	if _, err := provider.VariationsOf("simple-flag"); err == nil {
+		t.Error("Expected an error for a flag without an experiment")
+	}
+	if _, err := provider.VariationsOf("missing"); !errors.Is(err, ErrFlagNotFound) {
+		t.Errorf("Expected ErrFlagNotFound for an unknown flag, got %v", err)
+	}
+}
+
+func TestExperimentResultFor(t *testing.T) {
+	featuresJSON := `{
+		"checkout": {
+			"defaultValue": "none",
+			"rules": [{"key": "checkout-exp", "variations": ["control", "treatment"], "coverage": 1}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result, fromExperiment, err := provider.ExperimentResultFor(context.Background(), "checkout", openfeature.FlattenedContext{"id": "user-123"})
+	if err != nil {
+		t.Fatalf("ExperimentResultFor failed: %v", err)
+	}
+	if !fromExperiment || result == nil {
+		t.Fatal("Expected the flag to be served by an experiment")
+	}
+	if !result.InExperiment || !result.HashUsed {
+		t.Errorf("Expected a hashed experiment assignment, got %+v", result)
+	}
+	if result.HashAttribute != "id" || result.HashValue != "user-123" {
+		t.Errorf("Expected the user to be hashed by id, got %s=%s", result.HashAttribute, result.HashValue)
+	}
+	expectedValues := []string{"control", "treatment"}
+	if result.Value != expectedValues[result.VariationId] {
+		t.Errorf("Expected value %s for variation %d, got %v", expectedValues[result.VariationId], result.VariationId, result.Value)
+	}
+	if result.Bucket == nil {
+		t.Error("Expected the bucket to be reported")
+	}
+}
+
+func TestExperimentResultForNonExperimentFlag(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result, fromExperiment, err := provider.ExperimentResultFor(context.Background(), "bool-flag", nil)
+	if err != nil || fromExperiment || result != nil {
+		t.Errorf("Expected no experiment result for bool-flag, got %v %v %v", result, fromExperiment, err)
+	}
+
+	_, _, err = provider.ExperimentResultFor(context.Background(), "missing-flag", nil)
+	if !errors.Is(err, ErrFlagNotFound) {
+		t.Errorf("Expected ErrFlagNotFound, got %v", err)
+	}
+}
+
+type traceIDKey struct{}
+
+func TestTrackingCallbackReceivesEvaluationContext(t *testing.T) {
+	featuresJSON := `{
+		"exp-flag": {
+			"defaultValue": "control",
+			"rules": [{"key": "exp-flag-test", "variations": ["control", "treatment"], "coverage": 1}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+
+	var traceIDs []interface{}
+	provider := NewProvider(gbClient, false,
+		WithEvaluationTimeout(time.Second),
+		WithTrackingCallback(func(ctx context.Context, exp *gb.Experiment, result *gb.ExperimentResult) {
+			traceIDs = append(traceIDs, ctx.Value(traceIDKey{}))
+		}),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	ctx := context.WithValue(context.Background(), traceIDKey{}, "trace-1")
+	provider.StringEvaluation(ctx, "exp-flag", "none", openfeature.FlattenedContext{"id": "user-1"})
+
+	clone := provider.Clone(map[string]interface{}{"id": "user-2"})
+	ctx = context.WithValue(context.Background(), traceIDKey{}, "trace-2")
+	clone.StringEvaluation(ctx, "exp-flag", "none", nil)
+
+	expected := []interface{}{"trace-1", "trace-2"}
+	if !reflect.DeepEqual(traceIDs, expected) {
+		t.Errorf("Expected the callback to see trace IDs %v, got %v", expected, traceIDs)
+	}
+}
+
+func TestIDForVariationIsDeterministic(t *testing.T) {
+	featuresJSON := `{
+		"checkout": {
+			"defaultValue": "none",
+			"rules": [{"key": "checkout-exp", "seed": "fixed-seed", "variations": ["control", "treatment", "other"], "coverage": 1}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	tracked := 0
+	provider := NewProvider(gbClient, false, WithTrackingCallback(func(context.Context, *gb.Experiment, *gb.ExperimentResult) {
+		tracked++
+	}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	for variation, expected := range []string{"control", "treatment", "other"} {
+		id, err := provider.IDForVariation(context.Background(), "checkout", variation, nil)
+		if err != nil {
+			t.Fatalf("IDForVariation failed for variation %d: %v", variation, err)
+		}
+		if again, _ := provider.IDForVariation(context.Background(), "checkout", variation, nil); again != id {
+			t.Errorf("Expected the same id for variation %d, got %s and %s", variation, id, again)
+		}
+		for i := 0; i < 3; i++ {
+			result := provider.StringEvaluation(context.Background(), "checkout", "", openfeature.FlattenedContext{"id": id})
+			if result.Value != expected {
+				t.Errorf("Expected %s to be assigned %q, got %q", id, expected, result.Value)
+			}
+		}
+	}
+	if tracked != 9 {
+		t.Errorf("Expected only the 9 evaluations to be tracked, got %d", tracked)
+	}
+
+	if _, err := provider.IDForVariation(context.Background(), "missing-flag", 0, nil); !errors.Is(err, ErrFlagNotFound) {
+		t.Errorf("Expected ErrFlagNotFound, got %v", err)
+	}
+}
+
+func TestCoverageRolloutOfBooleanFlag(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"new-search": {"defaultValue": false, "rules": [{"key": "search-rollout", "variations": [false, true], "weights": [0, 1], "coverage": 0.5}]}
+	}`))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	counts := map[bool]int{}
+	for i := 0; i < 1000; i++ {
+		evalCtx := openfeature.FlattenedContext{"id": fmt.Sprintf("user-%d", i)}
+		result := provider.BooleanEvaluation(context.Background(), "new-search", false, evalCtx)
+		if result.Error() != nil {
+			t.Fatalf("Unexpected error: %v", result.Error())
+		}
+		counts[result.Value]++
+
+		if result.Value {
+			// Users inside the coverage are bucketed into the experiment
+			if result.Reason != openfeature.TargetingMatchReason {
+				t.Errorf("Expected %s for a rolled out user, got %s", openfeature.TargetingMatchReason, result.Reason)
+			}
+			if result.FlagMetadata["coverage"] != 0.5 || result.FlagMetadata["experiment"] != true {
+				t.Errorf("Expected the experiment's coverage in the metadata, got %v", result.FlagMetadata)
+			}
+		} else if result.Reason != openfeature.DefaultReason {
+			// Users outside it get the flag's default value
+			t.Errorf("Expected %s for a user outside the rollout, got %s", openfeature.DefaultReason, result.Reason)
+		}
+	}
+
+	if counts[true] < 400 || counts[false] < 400 {
+		t.Errorf("Expected both outcomes about half the time, got %v", counts)
+	}
+}
+
+func TestSplitReasonForExperiments(t *testing.T) {
+	features := `{
+		"plain": {"defaultValue": "a"},
+		"forced": {"defaultValue": "a", "rules": [{"force": "b"}]},
+		"split": {"defaultValue": "a", "rules": [{"key": "split-exp", "variations": ["a", "b"], "weights": [0.5, 0.5]}]},
+		"qa": {"defaultValue": "a", "rules": [{"key": "qa-exp", "variations": ["a", "b"], "weights": [0.5, 0.5]}]},
+		"overridden": {"defaultValue": "a"}
+	}`
+	newProvider := func(enabled bool) *Provider {
+		gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(features))
+		provider := NewProvider(gbClient, false,
+			WithSplitReasonForExperiments(enabled),
+			WithForcedVariations(map[string]int{"qa-exp": 1}),
+		)
+		provider.SetOverride("overridden", "b")
+		_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+		return provider
+	}
+
+	tests := []struct {
+		flag     string
+		disabled openfeature.Reason
+		enabled  openfeature.Reason
+	}{
+		{"plain", openfeature.DefaultReason, openfeature.DefaultReason},
+		{"forced", openfeature.TargetingMatchReason, openfeature.TargetingMatchReason},
+		{"split", openfeature.TargetingMatchReason, openfeature.SplitReason},
+		{"qa", openfeature.TargetingMatchReason, openfeature.TargetingMatchReason},
+		{"overridden", openfeature.TargetingMatchReason, openfeature.TargetingMatchReason},
+	}
+	evalCtx := openfeature.FlattenedContext{"id": "user-123"}
+	for _, enabled := range []bool{false, true} {
+		provider := newProvider(enabled)
+		for _, tt := range tests {
+			expected := tt.disabled
+			if enabled {
+				expected = tt.enabled
+			}
+			result := provider.StringEvaluation(context.Background(), tt.flag, "", evalCtx)
+			if result.Reason != expected {
+				t.Errorf("Expected %s for %s with the option set to %v, got %s", expected, tt.flag, enabled, result.Reason)
+			}
+		}
+	}
+}
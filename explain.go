@@ -0,0 +1,300 @@
+package growthbook
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// untrackedKey is the context key marking evaluations whose exposures aren't
+// reported to the tracking callback
+type untrackedKey struct{}
+
+// withoutTracking returns a copy of ctx for evaluations that shouldn't report
+// exposures, such as explanations requested by support tooling
+func withoutTracking(ctx context.Context) context.Context {
+	return context.WithValue(ctx, untrackedKey{}, true)
+}
+
+// Explanation describes how a flag resolved for an evaluation context, for
+// investigating why a user sees a value.
+type Explanation struct {
+	Flag  string
+	Value interface{}
+	// Source is GrowthBook's source of the value, such as "defaultValue",
+	// "force", "experiment" or "prerequisite"
+	Source string
+	// RuleID is the ID of the rule that matched, empty if no rule matched or
+	// the rule has no ID
+	RuleID string
+	// RuleIndex is the position of the matched rule in the flag's rules, or
+	// -1 if no rule matched or it can't be identified
+	RuleIndex int
+	Reason    openfeature.Reason
+	Variant   string
+	// ExperimentKey and VariationID identify the experiment variation the
+	// user was assigned, if the value came from an experiment
+	ExperimentKey string
+	VariationID   int
+	HashAttribute string
+	Metadata      openfeature.FlagMetadata
+	// Rules lists the flag's rules GrowthBook evaluated, in order, up to and
+	// including the rule that matched. It's nil when the value didn't come
+	// from evaluating the flag's rules, e.g. for overrides.
+	Rules []RuleEvaluation
+}
+
+// Checks that can stop a rule from matching, reported in RuleEvaluation
+const (
+	CheckParentCondition = "parentCondition" // A prerequisite's condition wasn't met
+	CheckFilters         = "filters"         // The user was filtered out by the rule's hash filters
+	CheckCondition       = "condition"       // The rule's targeting condition wasn't met
+	CheckCoverage        = "coverage"        // The user is outside a rollout's coverage
+	CheckHashAttribute   = "hashAttribute"   // The user lacks the experiment's hash attribute
+	CheckExperiment      = "experiment"      // The experiment didn't include the user (coverage, namespace, ...)
+	CheckNoValue         = "noValue"         // The rule has neither a force value nor variations
+	CheckSavedGroups     = "savedGroups"     // The rule's condition uses saved groups, which can't be replayed
+)
+
+// RuleEvaluation describes how one rule of a flag was evaluated
+type RuleEvaluation struct {
+	Index   int
+	ID      string
+	Matched bool
+	// FailedCheck is the first check that stopped the rule from matching,
+	// one of the Check constants, or empty if the rule matched
+	FailedCheck string
+	// ParentID is the prerequisite flag whose condition wasn't met, if
+	// FailedCheck is CheckParentCondition
+	ParentID string
+}
+
+// ExplainFlag explains how flag resolves for evalCtx. No exposures are
+// reported to the tracking callback, and the evaluation isn't recorded in the
+// history, audit log or metrics. An error is returned if the flag can't be
+// resolved, e.g. because it doesn't exist.
+func (p *Provider) ExplainFlag(ctx context.Context, flag string, evalCtx openfeature.FlattenedContext) (*Explanation, error) {
+	ctx = withoutTracking(ctx)
+	// Rules are replayed with the attributes the flag was evaluated with
+	evalCtx = p.withTimeAttribute(ctx, p.resolveAttributes(ctx, evalCtx))
+	return p.explainFlag(ctx, flag, evalCtx, p.newRuleProbe(evalCtx))
+}
+
+// explainFlag is ExplainFlag for an untracked ctx and a resolved evalCtx,
+// replaying rules with probe
+func (p *Provider) explainFlag(ctx context.Context, flag string, evalCtx openfeature.FlattenedContext, probe *ruleProbe) (*Explanation, error) {
+	feature, errDetail := p.resolveFlag(ctx, flag, evalCtx)
+	if errDetail != nil {
+		if err := ResolutionErr(*errDetail); err != nil {
+			return nil, fmt.Errorf("failed to explain flag '%s': %w", flag, err)
+		}
+		// Disabled by the kill switch
+		return &Explanation{
+			Flag:      flag,
+			RuleIndex: -1,
+			Reason:    errDetail.Reason,
+			Metadata:  errDetail.FlagMetadata,
+		}, nil
+	}
+
+	detail := p.createResolutionDetail(feature)
+	if feature.Value == nil {
+		detail = p.valuelessDetail(ctx, flag, feature, probe)
+	}
+	explanation := &Explanation{
+		Flag:      flag,
+		Value:     feature.Value,
+		Source:    string(feature.Source),
+		RuleID:    feature.RuleId,
+		RuleIndex: matchedRuleIndex(p.gbClient.Features()[flag], flag, feature),
+		Reason:    detail.Reason,
+		Variant:   detail.Variant,
+		Metadata:  detail.FlagMetadata,
+	}
+	if feature.ExperimentResult != nil && feature.ExperimentResult.InExperiment {
+		explanation.ExperimentKey = feature.ExperimentResult.Key
+		if feature.Experiment != nil {
+			explanation.ExperimentKey = feature.Experiment.Key
+		}
+		explanation.VariationID = feature.ExperimentResult.VariationId
+		explanation.HashAttribute = feature.ExperimentResult.HashAttribute
+	}
+	if !p.remoteEval && feature.Source != devOverrideSource && feature.Source != overrideSource && feature.Source != noHashAttributeSource {
+		explanation.Rules = p.explainRules(ctx, flag, probe, explanation.RuleIndex)
+	}
+	return explanation, nil
+}
+
+// ExplainAll explains every flag known to the client for evalCtx, keyed by
+// flag, as a full decision report for one user. Like ExplainFlag, it reports
+// no exposures, and it fails if any flag can't be resolved. Attributes are
+// resolved once, and one probe client replays the rules of every flag.
+func (p *Provider) ExplainAll(ctx context.Context, evalCtx openfeature.FlattenedContext) (map[string]*Explanation, error) {
+	ctx = withoutTracking(ctx)
+	evalCtx = p.withTimeAttribute(ctx, p.resolveAttributes(ctx, evalCtx))
+	probe := p.newRuleProbe(evalCtx)
+	features := p.gbClient.Features()
+	explanations := make(map[string]*Explanation, len(features))
+	for flag := range features {
+		explanation, err := p.explainFlag(ctx, flag, evalCtx, probe)
+		if err != nil {
+			return nil, err
+		}
+		explanations[flag] = explanation
+	}
+	return explanations, nil
+}
+
+// matchedRuleIndex returns the index of the rule of definition that produced
+// feature, matched by rule ID, or by experiment key for experiment rules
+// without one. It returns -1 if no rule matched or it can't be identified.
+func matchedRuleIndex(definition *gb.Feature, flag string, feature *gb.FeatureResult) int {
+	if definition == nil {
+		return -1
+	}
+	for i := range definition.Rules {
+		rule := &definition.Rules[i]
+		if feature.RuleId != "" {
+			if rule.Id == feature.RuleId {
+				return i
+			}
+			continue
+		}
+		if feature.Source != gb.ExperimentResultSource || feature.Experiment == nil || len(rule.Variations) == 0 {
+			continue
+		}
+		key := rule.Key
+		if key == "" {
+			key = flag
+		}
+		if key == feature.Experiment.Key {
+			return i
+		}
+	}
+	return -1
+}
+
+// explainRules replays the rules of flag one at a time to report why each
+// rule GrowthBook evaluated did or didn't match. GrowthBook only reports the
+// rule that matched, and its conditions can only be evaluated by the SDK, so
+// each rule's checks are added one at a time to probe in place of the flag, in
+// the order GrowthBook runs them, until the probe stops matching. The probe
+// doesn't have the client's saved groups, so rules whose condition uses saved
+// groups aren't replayed past their prerequisites: they match only if they're
+// the rule at ruleIndex, which GrowthBook reported, and fail CheckSavedGroups
+// otherwise. Saved groups set with WithGroupMembership are replayed.
+func (p *Provider) explainRules(ctx context.Context, flag string, probe *ruleProbe, ruleIndex int) []RuleEvaluation {
+	definition := p.gbClient.Features()[flag]
+	if definition == nil || !probe.ready(ctx) {
+		return nil
+	}
+	// The probe replaces the flag itself, so hashing seeds default to the
+	// flag's key as they do when it's evaluated
+	probeRule := func(rule gb.FeatureRule) gb.FeatureResultSource {
+		return probe.eval(ctx, flag, rule)
+	}
+
+	var rules []RuleEvaluation
+	for i := range definition.Rules {
+		rule := definition.Rules[i]
+		evaluation := RuleEvaluation{Index: i, ID: rule.Id}
+		gated := false
+		for j := range rule.ParentConditions {
+			source := probeRule(gb.FeatureRule{ParentConditions: rule.ParentConditions[:j+1], Force: true})
+			if source != gb.ForceResultSource {
+				evaluation.FailedCheck = CheckParentCondition
+				evaluation.ParentID = rule.ParentConditions[j].Id
+				// A gating prerequisite turns the whole flag off
+				gated = source == gb.PrerequisiteResultSource
+				break
+			}
+		}
+		switch {
+		case evaluation.FailedCheck != "":
+		case p.groupMembership == nil && usesSavedGroups(reflect.ValueOf(rule.Condition)):
+			if i != ruleIndex {
+				evaluation.FailedCheck = CheckSavedGroups
+			}
+		default:
+			evaluation.FailedCheck = failedRuleCheck(rule, probe.attrs, probeRule)
+		}
+		evaluation.Matched = evaluation.FailedCheck == ""
+		rules = append(rules, evaluation)
+		if evaluation.Matched || gated {
+			break
+		}
+	}
+	return rules
+}
+
+// failedRuleCheck returns the first check after the prerequisites that stops
+// rule from matching, or "" if it matches
+func failedRuleCheck(rule gb.FeatureRule, attrs gb.Attributes, probe func(gb.FeatureRule) gb.FeatureResultSource) string {
+	if len(rule.Filters) > 0 && probe(gb.FeatureRule{Filters: rule.Filters, Force: true}) != gb.ForceResultSource {
+		return CheckFilters
+	}
+	switch {
+	case rule.Force != nil:
+		if probe(gb.FeatureRule{Condition: rule.Condition, Force: true}) != gb.ForceResultSource {
+			return CheckCondition
+		}
+		if probe(rule) != gb.ForceResultSource {
+			return CheckCoverage
+		}
+	case len(rule.Variations) > 0:
+		if probe(rule) == gb.ExperimentResultSource {
+			return ""
+		}
+		// GrowthBook checks the hash attribute before the condition
+		hashAttribute := rule.HashAttribute
+		if hashAttribute == "" {
+			hashAttribute = "id"
+		}
+		if !hasHashValue(attrs[hashAttribute]) {
+			return CheckHashAttribute
+		}
+		if probe(gb.FeatureRule{Condition: rule.Condition, Force: true}) != gb.ForceResultSource {
+			return CheckCondition
+		}
+		return CheckExperiment
+	default:
+		return CheckNoValue
+	}
+	return ""
+}
+
+// usesSavedGroups reports whether the parsed condition v has an $inGroup or
+// $notInGroup operator. The SDK's condition types are internal, so they're
+// walked by reflection and the operator is recognized by its type's name.
+func usesSavedGroups(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		return !v.IsNil() && usesSavedGroups(v.Elem())
+	case reflect.Struct:
+		if v.Type().Name() == "InGroupCond" {
+			return true
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if usesSavedGroups(v.Field(i)) {
+				return true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if usesSavedGroups(v.Index(i)) {
+				return true
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if usesSavedGroups(iter.Value()) {
+				return true
+			}
+		}
+	}
+	return false
+}
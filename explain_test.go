@@ -0,0 +1,243 @@
+package growthbook
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+const explainFeatures = `{
+	"plain": {"defaultValue": "v1"},
+	"targeted": {"defaultValue": false, "rules": [
+		{"id": "us-rule", "condition": {"country": "US"}, "force": true},
+		{"id": "nz-rule", "condition": {"country": "NZ"}, "force": true}
+	]},
+	"exp-flag": {"defaultValue": "none", "rules": [
+		{"condition": {"country": "US"}, "force": "us"},
+		{"key": "cta-exp", "variations": ["control", "treatment"], "coverage": 1}
+	]}
+}`
+
+func TestExplainAll(t *testing.T) {
+	var exposures int
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(explainFeatures))
+	provider := NewProvider(gbClient, false, WithTrackingCallback(func(context.Context, *gb.Experiment, *gb.ExperimentResult) {
+		exposures++
+	}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	explanations, err := provider.ExplainAll(context.Background(), openfeature.FlattenedContext{"id": "user-1", "country": "NZ"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(explanations) != 3 {
+		t.Fatalf("Expected an explanation per flag, got %d", len(explanations))
+	}
+
+	plain := explanations["plain"]
+	if plain.Value != "v1" || plain.Source != string(gb.DefaultValueResultSource) || plain.RuleIndex != -1 || plain.Reason != openfeature.DefaultReason {
+		t.Errorf("Unexpected explanation for plain: %+v", plain)
+	}
+
+	targeted := explanations["targeted"]
+	if targeted.Value != true || targeted.Source != string(gb.ForceResultSource) || targeted.RuleID != "nz-rule" || targeted.RuleIndex != 1 {
+		t.Errorf("Expected the nz-rule force rule to match, got %+v", targeted)
+	}
+
+	exp := explanations["exp-flag"]
+	if exp.Source != string(gb.ExperimentResultSource) || exp.RuleIndex != 1 || exp.ExperimentKey != "cta-exp" || exp.HashAttribute != "id" {
+		t.Errorf("Expected the cta-exp experiment rule to match, got %+v", exp)
+	}
+	if (exp.VariationID == 0) != (exp.Value == "control") {
+		t.Errorf("Expected the variation ID to match the value, got %+v", exp)
+	}
+
+	if exposures != 0 {
+		t.Errorf("Expected no exposures to be reported for explanations, got %d", exposures)
+	}
+}
+
+func TestExplainFlagNotFound(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	if _, err := provider.ExplainFlag(context.Background(), "missing-flag", nil); !errors.Is(err, ErrFlagNotFound) {
+		t.Errorf("Expected ErrFlagNotFound, got %v", err)
+	}
+}
+
+func TestExplainFlagListsEvaluatedRules(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"banner": {"defaultValue": "none", "rules": [
+			{"id": "us-only", "condition": {"country": "US"}, "force": "us"},
+			{"id": "nz-rollout", "condition": {"country": "NZ"}, "force": "nz", "coverage": 1},
+			{"id": "everyone", "force": "all"}
+		]},
+		"exp-flag": {"defaultValue": "none", "rules": [
+			{"id": "no-rollout", "force": "rolled-out", "coverage": 0},
+			{"id": "device-exp", "hashAttribute": "deviceId", "variations": ["a", "b"]},
+			{"id": "beta-exp", "condition": {"beta": true}, "variations": ["a", "b"]}
+		]}
+	}`))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	explanation, err := provider.ExplainFlag(context.Background(), "banner", openfeature.FlattenedContext{"id": "user-1", "country": "NZ"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []RuleEvaluation{
+		{Index: 0, ID: "us-only", FailedCheck: CheckCondition},
+		{Index: 1, ID: "nz-rollout", Matched: true},
+	}
+	if !reflect.DeepEqual(explanation.Rules, expected) {
+		t.Errorf("Expected rule 1 skipped and rule 2 matched, got %+v", explanation.Rules)
+	}
+	if explanation.Value != "nz" || explanation.RuleIndex != 1 {
+		t.Errorf("Expected the nz-rollout value, got %+v", explanation)
+	}
+
+	// Without a match, every rule is listed with the check that failed it
+	explanation, _ = provider.ExplainFlag(context.Background(), "exp-flag", openfeature.FlattenedContext{"id": "user-1"})
+	expected = []RuleEvaluation{
+		{Index: 0, ID: "no-rollout", FailedCheck: CheckCoverage},
+		{Index: 1, ID: "device-exp", FailedCheck: CheckHashAttribute},
+		{Index: 2, ID: "beta-exp", FailedCheck: CheckCondition},
+	}
+	if !reflect.DeepEqual(explanation.Rules, expected) {
+		t.Errorf("Expected each rule's failed check, got %+v", explanation.Rules)
+	}
+}
+
+func TestExplainFlagGatedByPrerequisite(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"parent": {"defaultValue": false},
+		"child": {"defaultValue": "on", "rules": [
+			{"id": "gate", "parentConditions": [{"id": "parent", "condition": {"value": true}, "gate": true}]},
+			{"id": "later", "force": "later"}
+		]}
+	}`))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	explanation, err := provider.ExplainFlag(context.Background(), "child", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []RuleEvaluation{{Index: 0, ID: "gate", FailedCheck: CheckParentCondition, ParentID: "parent"}}
+	if !reflect.DeepEqual(explanation.Rules, expected) {
+		t.Errorf("Expected evaluation to stop at the gate, got %+v", explanation.Rules)
+	}
+}
+
+func TestExplainFlagReplaysResolvedAttributes(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"banner": {"defaultValue": "none", "rules": [
+			{"id": "eu-only", "condition": {"region": "eu"}, "force": "eu"},
+			{"id": "pro-only", "condition": {"plan": "pro"}, "force": "pro"}
+		]}
+	}`))
+	provider := NewProvider(gbClient, false,
+		WithAttributeSources(attributeSourceFunc(func(context.Context) (map[string]interface{}, error) {
+			return map[string]interface{}{"region": "us"}, nil
+		})),
+		WithAttributeResolver(func(context.Context, map[string]interface{}) map[string]interface{} {
+			return map[string]interface{}{"plan": "pro"}
+		}),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	explanation, err := provider.ExplainFlag(context.Background(), "banner", openfeature.FlattenedContext{"id": "user-1"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []RuleEvaluation{
+		{Index: 0, ID: "eu-only", FailedCheck: CheckCondition},
+		{Index: 1, ID: "pro-only", Matched: true},
+	}
+	if explanation.RuleIndex != 1 || !reflect.DeepEqual(explanation.Rules, expected) {
+		t.Errorf("Expected the resolved plan to match pro-only, got rule %d and %+v", explanation.RuleIndex, explanation.Rules)
+	}
+}
+
+func TestExplainFlagDoesNotReplaySavedGroups(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background())
+	err := gbClient.UpdateFromApiResponseJSON(`{
+		"features": {"beta-banner": {"defaultValue": "none", "rules": [
+			{"id": "staff", "condition": {"email": {"$regex": "@growthbook\\.io$"}}, "force": "staff"},
+			{"id": "beta", "condition": {"id": {"$inGroup": "beta-testers"}}, "force": "beta"},
+			{"id": "not-beta", "condition": {"$not": {"id": {"$inGroup": "beta-testers"}}}, "force": "other"}
+		]}},
+		"savedGroups": {"beta-testers": ["user-1"]}
+	}`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	explanation, _ := provider.ExplainFlag(context.Background(), "beta-banner", openfeature.FlattenedContext{"id": "user-1"})
+	expected := []RuleEvaluation{
+		{Index: 0, ID: "staff", FailedCheck: CheckCondition},
+		{Index: 1, ID: "beta", Matched: true},
+	}
+	if explanation.RuleIndex != 1 || !reflect.DeepEqual(explanation.Rules, expected) {
+		t.Errorf("Expected the group rule GrowthBook matched to be reported, got rule %d and %+v", explanation.RuleIndex, explanation.Rules)
+	}
+
+	explanation, _ = provider.ExplainFlag(context.Background(), "beta-banner", openfeature.FlattenedContext{"id": "user-2"})
+	expected = []RuleEvaluation{
+		{Index: 0, ID: "staff", FailedCheck: CheckCondition},
+		{Index: 1, ID: "beta", FailedCheck: CheckSavedGroups},
+		{Index: 2, ID: "not-beta", Matched: true},
+	}
+	if explanation.RuleIndex != 2 || !reflect.DeepEqual(explanation.Rules, expected) {
+		t.Errorf("Expected group rules not to be replayed, got rule %d and %+v", explanation.RuleIndex, explanation.Rules)
+	}
+
+	// Group membership set on the provider is replayed
+	forced := NewProvider(gbClient, false, WithGroupMembership(map[string]bool{"beta-testers": false}))
+	_ = forced.Init(openfeature.NewEvaluationContext("", nil))
+	explanation, _ = forced.ExplainFlag(context.Background(), "beta-banner", openfeature.FlattenedContext{"id": "user-1"})
+	expected[1].FailedCheck = CheckCondition
+	if explanation.RuleIndex != 2 || !reflect.DeepEqual(explanation.Rules, expected) {
+		t.Errorf("Expected forced group membership to be replayed, got rule %d and %+v", explanation.RuleIndex, explanation.Rules)
+	}
+}
+
+func TestExplainAllMatchesExplainFlag(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"parent": {"defaultValue": false, "rules": [
+			{"id": "nz", "condition": {"country": "NZ"}, "force": true, "coverage": 0},
+			{"id": "nz-exp", "condition": {"country": "NZ"}, "variations": [false, true], "coverage": 0}
+		]},
+		"child": {"defaultValue": "off", "rules": [
+			{"id": "needs-parent", "parentConditions": [{"id": "parent", "condition": {"value": true}}], "force": "on"}
+		]},
+		"gated": {"defaultValue": "on", "rules": [
+			{"id": "gate", "parentConditions": [{"id": "parent", "condition": {"value": true}, "gate": true}]}
+		]},
+		"targeted": {"defaultValue": false, "rules": [{"id": "us", "condition": {"country": "US"}, "force": true}]}
+	}`))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	// The shared probe must leave each flag as it was for the flags explained after it
+	for _, country := range []string{"NZ", "US"} {
+		evalCtx := openfeature.FlattenedContext{"id": "user-1", "country": country}
+		explanations, err := provider.ExplainAll(context.Background(), evalCtx)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for flag, explanation := range explanations {
+			single, _ := provider.ExplainFlag(context.Background(), flag, evalCtx)
+			if !reflect.DeepEqual(explanation, single) {
+				t.Errorf("Expected ExplainAll to explain %s in %s like ExplainFlag, got %+v and %+v", flag, country, explanation, single)
+			}
+		}
+	}
+}
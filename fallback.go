@@ -0,0 +1,118 @@
+package growthbook
+
+import (
+	"context"
+	"fmt"
+
+	gb "github.com/growthbook/growthbook-golang"
+)
+
+// evaluateWithFallback runs the flag's experiment rules whose hash attribute is
+// missing from attrs, bucketing by the fallback attribute configured with
+// WithFallbackAttributes. It returns nil if no fallback applies or the user
+// isn't included in any of the experiments.
+//
+// It's only consulted when regular evaluation fell through to the default
+// value, so an experiment rule placed before a matching force rule is not
+// reached through its fallback.
+func (p *Provider) evaluateWithFallback(ctx context.Context, client *gb.Client, flag string, attrs gb.Attributes) *gb.FeatureResult {
+	fallback, ok := p.fallbackAttrs[flag]
+	if !ok || !hasHashValue(attrs[fallback]) {
+		return nil
+	}
+	feature := client.Features()[flag]
+	if feature == nil {
+		return nil
+	}
+
+	// Exposures are reported below, once the result names the attribute used
+	untracked, _ := client.WithExperimentCallback(nil)
+
+	for i := range feature.Rules {
+		rule := &feature.Rules[i]
+		if len(rule.Variations) == 0 {
+			continue
+		}
+		hashAttribute := rule.HashAttribute
+		if hashAttribute == "" {
+			hashAttribute = "id"
+		}
+		if hasHashValue(attrs[hashAttribute]) {
+			// Regular evaluation already bucketed on the hash attribute
+			continue
+		}
+
+		exp := experimentFromRule(flag, rule)
+		exp.FallbackAttribute = fallback
+		result := untracked.RunExperiment(ctx, exp)
+		if !result.InExperiment || result.Passthrough {
+			continue
+		}
+
+		// The SDK reports the primary hash attribute even when it bucketed on
+		// the fallback, so correct a copy of the result
+		annotated := *result
+		annotated.HashAttribute = fallback
+		annotated.HashValue = fmt.Sprint(attrs[fallback])
+		p.track(ctx, exp, &annotated)
+
+		on := truthy(annotated.Value)
+		return &gb.FeatureResult{
+			RuleId:           rule.Id,
+			Value:            annotated.Value,
+			Source:           gb.ExperimentResultSource,
+			On:               on,
+			Off:              !on,
+			Experiment:       exp,
+			ExperimentResult: &annotated,
+		}
+	}
+	return nil
+}
+
+// experimentFromRule builds the experiment GrowthBook runs for a feature rule
+func experimentFromRule(flag string, rule *gb.FeatureRule) *gb.Experiment {
+	key := rule.Key
+	if key == "" {
+		key = flag
+	}
+	return &gb.Experiment{
+		Key:              key,
+		Variations:       rule.Variations,
+		Coverage:         rule.Coverage,
+		Weights:          rule.Weights,
+		HashAttribute:    rule.HashAttribute,
+		Namespace:        rule.Namespace,
+		Meta:             rule.Meta,
+		Ranges:           rule.Ranges,
+		Name:             rule.Name,
+		Phase:            rule.Phase,
+		Seed:             rule.Seed,
+		HashVersion:      rule.HashVersion,
+		Filters:          rule.Filters,
+		Condition:        rule.Condition,
+		ParentConditions: rule.ParentConditions,
+	}
+}
+
+// hasHashValue reports whether an attribute value can be hashed for bucketing
+func hasHashValue(value interface{}) bool {
+	return value != nil && value != ""
+}
+
+// truthy mirrors GrowthBook's JavaScript-style truthiness of feature values
+func truthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case float64:
+		return v != 0
+	case int:
+		return v != 0
+	}
+	return true
+}
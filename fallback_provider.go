@@ -0,0 +1,59 @@
+package growthbook
+
+import (
+	"context"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// servedByFallback is the "servedBy" metadata of results served by the
+// fallback provider
+const servedByFallback = "fallback"
+
+// delegatesToFallback reports whether a flag that failed with detail should be
+// resolved by the fallback provider instead
+func (p *Provider) delegatesToFallback(detail openfeature.ProviderResolutionDetail) bool {
+	if p.fallbackProvider == nil {
+		return false
+	}
+	code := detail.ResolutionDetail().ErrorCode
+	return code == openfeature.FlagNotFoundCode || code == openfeature.ProviderNotReadyCode
+}
+
+// resolveWithFallback resolves flag with the fallback provider's evaluation
+// method matching T. It reports false if the fallback couldn't resolve the
+// flag either.
+func resolveWithFallback[T any](p *Provider, ctx context.Context, flag string, defaultValue T, evalCtx openfeature.FlattenedContext) (T, openfeature.ProviderResolutionDetail, bool) {
+	var value interface{}
+	var detail openfeature.ProviderResolutionDetail
+	switch v := any(&defaultValue).(type) {
+	case *bool:
+		result := p.fallbackProvider.BooleanEvaluation(ctx, flag, *v, evalCtx)
+		value, detail = result.Value, result.ProviderResolutionDetail
+	case *string:
+		result := p.fallbackProvider.StringEvaluation(ctx, flag, *v, evalCtx)
+		value, detail = result.Value, result.ProviderResolutionDetail
+	case *float64:
+		result := p.fallbackProvider.FloatEvaluation(ctx, flag, *v, evalCtx)
+		value, detail = result.Value, result.ProviderResolutionDetail
+	case *int64:
+		result := p.fallbackProvider.IntEvaluation(ctx, flag, *v, evalCtx)
+		value, detail = result.Value, result.ProviderResolutionDetail
+	default:
+		result := p.fallbackProvider.ObjectEvaluation(ctx, flag, defaultValue, evalCtx)
+		value, detail = result.Value, result.ProviderResolutionDetail
+	}
+
+	typed, ok := value.(T)
+	if detail.Error() != nil || !ok {
+		return defaultValue, detail, false
+	}
+
+	metadata := make(openfeature.FlagMetadata, len(detail.FlagMetadata)+1)
+	for key, v := range detail.FlagMetadata {
+		metadata[key] = v
+	}
+	metadata["servedBy"] = servedByFallback
+	detail.FlagMetadata = metadata
+	return typed, detail, true
+}
@@ -0,0 +1,83 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/open-feature/go-sdk/openfeature"
+	"github.com/open-feature/go-sdk/openfeature/memprovider"
+)
+
+func newStaticFallback() memprovider.InMemoryProvider {
+	return memprovider.NewInMemoryProvider(map[string]memprovider.InMemoryFlag{
+		"static-flag": {
+			Key:            "static-flag",
+			State:          memprovider.Enabled,
+			DefaultVariant: "on",
+			Variants:       map[string]interface{}{"on": "from fallback"},
+		},
+	})
+}
+
+func TestFallbackProviderServesMissingFlag(t *testing.T) {
+	provider := setupTestProvider(WithFallbackProvider(newStaticFallback()))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.StringEvaluation(context.Background(), "static-flag", "default", nil)
+	if result.Value != "from fallback" {
+		t.Errorf("Expected the fallback's value, got %q (error: %v)", result.Value, result.Error())
+	}
+	if result.FlagMetadata["servedBy"] != "fallback" {
+		t.Errorf("Expected servedBy metadata, got %v", result.FlagMetadata)
+	}
+
+	// Flags GrowthBook knows are still served by GrowthBook
+	own := provider.StringEvaluation(context.Background(), "string-flag", "default", nil)
+	if own.Value != "default-string" || own.FlagMetadata["servedBy"] != nil {
+		t.Errorf("Expected string-flag to be served by GrowthBook, got %q %v", own.Value, own.FlagMetadata)
+	}
+}
+
+func TestFallbackProviderServesWhileNotReady(t *testing.T) {
+	provider := setupTestProvider(WithFallbackProvider(newStaticFallback()))
+
+	result := provider.StringEvaluation(context.Background(), "static-flag", "default", nil)
+	if result.Value != "from fallback" {
+		t.Errorf("Expected the fallback's value before Init, got %q", result.Value)
+	}
+}
+
+func TestFallbackProviderMissingFlagKeepsOwnError(t *testing.T) {
+	provider := setupTestProvider(WithFallbackProvider(newStaticFallback()))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.BooleanEvaluation(context.Background(), "unknown-flag", true, nil)
+	if result.Value != true {
+		t.Errorf("Expected the caller's default, got %v", result.Value)
+	}
+	if result.ResolutionDetail().ErrorCode != openfeature.FlagNotFoundCode {
+		t.Errorf("Expected FLAG_NOT_FOUND, got %v", result.ResolutionDetail().ErrorCode)
+	}
+}
+
+func TestFallbackProviderServesObjectEvaluationJSON(t *testing.T) {
+	fallback := memprovider.NewInMemoryProvider(map[string]memprovider.InMemoryFlag{
+		"static-config": {
+			Key:            "static-config",
+			State:          memprovider.Enabled,
+			DefaultVariant: "on",
+			Variants:       map[string]interface{}{"on": map[string]interface{}{"theme": "dark"}},
+		},
+	})
+	provider := setupTestProvider(WithFallbackProvider(fallback))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.ObjectEvaluationJSON(context.Background(), "static-config", json.RawMessage(`{}`), nil)
+	if string(result.Value) != `{"theme":"dark"}` {
+		t.Errorf("Expected the fallback's value as JSON, got %s (error: %v)", result.Value, result.Error())
+	}
+	if result.FlagMetadata["servedBy"] != "fallback" {
+		t.Errorf("Expected servedBy metadata, got %v", result.FlagMetadata)
+	}
+}
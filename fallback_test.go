@@ -0,0 +1,96 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestFallbackAttributeBucketing(t *testing.T) {
+	featuresJSON := `{
+		"checkout": {
+			"defaultValue": "none",
+			"rules": [{"key": "checkout-exp", "variations": ["a", "b", "c", "d"]}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, false, WithFallbackAttributes(map[string]string{"checkout": "deviceId"}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	anonymous := openfeature.FlattenedContext{"deviceId": "device-42"}
+	result := provider.StringEvaluation(context.Background(), "checkout", "fallback", anonymous)
+	if result.Value == "none" {
+		t.Fatal("Expected anonymous user to be bucketed via the fallback attribute")
+	}
+	if result.FlagMetadata["hashAttribute"] != "deviceId" {
+		t.Errorf("Expected hashAttribute metadata 'deviceId', got %v", result.FlagMetadata["hashAttribute"])
+	}
+
+	// Bucketing is stable and matches hashing on the device id value
+	for i := 0; i < 5; i++ {
+		again := provider.StringEvaluation(context.Background(), "checkout", "fallback", anonymous)
+		if again.Value != result.Value {
+			t.Fatalf("Expected stable bucketing %q, got %q", result.Value, again.Value)
+		}
+	}
+	byID := provider.StringEvaluation(context.Background(), "checkout", "fallback", openfeature.FlattenedContext{"id": "device-42"})
+	if byID.Value != result.Value {
+		t.Errorf("Expected fallback bucketing %q to match id bucketing %q", result.Value, byID.Value)
+	}
+	if byID.FlagMetadata["hashAttribute"] != "id" {
+		t.Errorf("Expected hashAttribute metadata 'id' when id is present, got %v", byID.FlagMetadata["hashAttribute"])
+	}
+}
+
+func TestFallbackAttributeKeepsConditionsAndExposures(t *testing.T) {
+	featuresJSON := `{
+		"anonymous-exp": {
+			"defaultValue": "none",
+			"rules": [{"condition": {"id": {"$exists": false}}, "variations": ["a", "b"]}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+
+	var exposures []*gb.ExperimentResult
+	provider := NewProvider(gbClient, false,
+		WithFallbackAttributes(map[string]string{"anonymous-exp": "deviceId"}),
+		WithTrackingCallback(func(ctx context.Context, exp *gb.Experiment, result *gb.ExperimentResult) {
+			exposures = append(exposures, result)
+		}),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.StringEvaluation(context.Background(), "anonymous-exp", "fallback", openfeature.FlattenedContext{"deviceId": "device-42"})
+	if result.Value == "none" {
+		t.Fatal("Expected the condition on a missing id to still match")
+	}
+
+	if len(exposures) != 1 {
+		t.Fatalf("Expected 1 exposure, got %d", len(exposures))
+	}
+	if exposures[0].HashAttribute != "deviceId" || exposures[0].HashValue != "device-42" {
+		t.Errorf("Expected exposure hashed on deviceId=device-42, got %s=%s", exposures[0].HashAttribute, exposures[0].HashValue)
+	}
+	if result.FlagMetadata["hashAttribute"] != exposures[0].HashAttribute {
+		t.Errorf("Expected metadata and exposure to agree, got %v and %s", result.FlagMetadata["hashAttribute"], exposures[0].HashAttribute)
+	}
+}
+
+func TestFallbackAttributeNotConfigured(t *testing.T) {
+	featuresJSON := `{
+		"checkout": {
+			"defaultValue": "none",
+			"rules": [{"variations": ["a", "b"]}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.StringEvaluation(context.Background(), "checkout", "fallback", openfeature.FlattenedContext{"deviceId": "device-42"})
+	if result.Value != "none" {
+		t.Errorf("Expected default value without a hash attribute, got %q", result.Value)
+	}
+}
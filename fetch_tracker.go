@@ -0,0 +1,127 @@
+package growthbook
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FetchTracker is an http.RoundTripper that records when the GrowthBook client
+// last fetched features successfully. The GrowthBook client doesn't report its
+// fetches, and it leaves its features untouched when the API answers 304 Not
+// Modified or an SSE stream has nothing new to send, so watching the HTTP
+// traffic is the only reliable way to tell a healthy data source from a stalled one.
+//
+// Pass it to the client with gb.WithHttpClient and to the provider with
+// WithFetchTracker:
+//
+//	tracker := growthbook.NewFetchTracker(nil)
+//	gbClient, _ := gb.NewClient(ctx, gb.WithHttpClient(tracker.HTTPClient()), gb.WithSseDataSource(), ...)
+//	provider := growthbook.NewProvider(gbClient, growthbook.WithFetchTracker(tracker))
+type FetchTracker struct {
+	base http.RoundTripper
+
+	mu          sync.RWMutex
+	now         func() time.Time
+	lastSuccess time.Time
+	mode        DataSourceMode
+	listeners   []func()
+}
+
+// NewFetchTracker creates a FetchTracker that sends requests through base, or
+// through http.DefaultTransport if base is nil.
+func NewFetchTracker(base http.RoundTripper) *FetchTracker {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &FetchTracker{
+		base: base,
+		now:  time.Now,
+	}
+}
+
+// HTTPClient returns an http.Client that uses the tracker as its transport
+func (t *FetchTracker) HTTPClient() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// RoundTrip implements http.RoundTripper. Responses with status 200 or 304
+// count as successful fetches. For an SSE stream, every chunk of data read
+// from it counts as well.
+func (t *FetchTracker) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotModified {
+		return resp, nil
+	}
+
+	mode := DataSourcePoll
+	if strings.Contains(req.URL.Path, "/sub/") {
+		mode = DataSourceSSE
+		resp.Body = &trackedBody{ReadCloser: resp.Body, tracker: t}
+	}
+	t.recordSuccess(mode)
+	return resp, nil
+}
+
+// LastSuccess returns the time of the last successful fetch (zero if none)
+func (t *FetchTracker) LastSuccess() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.lastSuccess
+}
+
+// Mode returns the kind of data source seen in the client's requests, or an
+// empty string before the first successful fetch.
+func (t *FetchTracker) Mode() DataSourceMode {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.mode
+}
+
+// setClock replaces the time source used to timestamp fetches
+func (t *FetchTracker) setClock(now func() time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.now = now
+}
+
+// subscribe registers a function called after every successful fetch
+func (t *FetchTracker) subscribe(listener func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.listeners = append(t.listeners, listener)
+}
+
+func (t *FetchTracker) recordSuccess(mode DataSourceMode) {
+	t.mu.Lock()
+	t.lastSuccess = t.now()
+	// The SSE data source makes a plain features request first, so SSE wins
+	if t.mode != DataSourceSSE {
+		t.mode = mode
+	}
+	listeners := t.listeners
+	t.mu.Unlock()
+
+	for _, listener := range listeners {
+		listener()
+	}
+}
+
+// trackedBody records a successful fetch whenever data arrives on an SSE stream
+type trackedBody struct {
+	io.ReadCloser
+	tracker *FetchTracker
+}
+
+func (b *trackedBody) Read(buf []byte) (int, error) {
+	n, err := b.ReadCloser.Read(buf)
+	if n > 0 {
+		b.tracker.recordSuccess(DataSourceSSE)
+	}
+	return n, err
+}
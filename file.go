@@ -0,0 +1,50 @@
+package growthbook
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+
+	gb "github.com/growthbook/growthbook-golang"
+)
+
+// NewProviderFromFile creates a provider serving the features in the JSON file
+// at path, without a data source, for offline tests and demos. The file holds
+// either a features map, as given to gb.WithJsonFeatures, or a GrowthBook API
+// response with a "features" field.
+func NewProviderFromFile(path string, opts ...Option) (*Provider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read features file: %w", err)
+	}
+	return newProviderFromJSON(data, opts)
+}
+
+// NewProviderFromFS is NewProviderFromFile for a file in fsys, typically an
+// embed.FS holding fixtures compiled into a test binary.
+func NewProviderFromFS(fsys fs.FS, path string, opts ...Option) (*Provider, error) {
+	data, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read features file: %w", err)
+	}
+	return newProviderFromJSON(data, opts)
+}
+
+// newProviderFromJSON creates a data-source-free provider serving the features
+// in data
+func newProviderFromJSON(data []byte, opts []Option) (*Provider, error) {
+	gbClient, err := gb.NewClient(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	if err := setFeaturesJSON(gbClient, data); err != nil {
+		return nil, fmt.Errorf("failed to parse features file: %w", err)
+	}
+
+	options := []interface{}{false}
+	for _, opt := range opts {
+		options = append(options, opt)
+	}
+	return NewProvider(gbClient, options...), nil
+}
@@ -0,0 +1,64 @@
+package growthbook
+
+import (
+	"context"
+	"embed"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+//go:embed testdata/features.json
+var fixtures embed.FS
+
+func TestNewProviderFromFS(t *testing.T) {
+	provider, err := NewProviderFromFS(fixtures, "testdata/features.json")
+	if err != nil {
+		t.Fatalf("NewProviderFromFS failed: %v", err)
+	}
+	if err := provider.Init(openfeature.NewEvaluationContext("", nil)); err != nil {
+		t.Fatalf("Expected Init to succeed without a data source, got %v", err)
+	}
+
+	result := provider.StringEvaluation(context.Background(), "welcome-message", "", openfeature.FlattenedContext{"country": "FR"})
+	if result.Value != "Bonjour" {
+		t.Errorf("Expected 'Bonjour' from the fixture, got %q", result.Value)
+	}
+	if items := provider.IntEvaluation(context.Background(), "max-items", 0, nil); items.Value != 20 {
+		t.Errorf("Expected max-items 20 from the fixture, got %d", items.Value)
+	}
+}
+
+func TestNewProviderFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "features.json")
+	if err := os.WriteFile(path, []byte(`{"dark-mode": {"defaultValue": true}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	provider, err := NewProviderFromFile(path, WithEvaluationHistory(1))
+	if err != nil {
+		t.Fatalf("NewProviderFromFile failed: %v", err)
+	}
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	if result := provider.BooleanEvaluation(context.Background(), "dark-mode", false, nil); !result.Value {
+		t.Error("Expected dark-mode to be true from the file")
+	}
+	if len(provider.RecentEvaluations()) != 1 {
+		t.Error("Expected the options to be applied")
+	}
+}
+
+func TestNewProviderFromFileErrors(t *testing.T) {
+	if _, err := NewProviderFromFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+
+	path := filepath.Join(t.TempDir(), "invalid.json")
+	_ = os.WriteFile(path, []byte(`not json`), 0o600)
+	if _, err := NewProviderFromFile(path); err == nil {
+		t.Error("Expected an error for invalid JSON")
+	}
+}
@@ -0,0 +1,88 @@
+// Package flagd exports the features loaded by a GrowthBook provider as a
+// flagd flag configuration, so flagd and other OpenFeature infrastructure can
+// consume them.
+//
+// Handler serves the configuration over HTTP, which flagd consumes with its
+// HTTP sync provider (--uri https://host/flagd/flags.json). flagd polls the
+// URL, so reloads of the GrowthBook features reach it within its polling
+// interval. flagd's gRPC sync service, which pushes reloads, is implemented
+// by the grpcsync module, so this module doesn't depend on gRPC.
+package flagd
+
+import (
+	"fmt"
+
+	gb "github.com/growthbook/growthbook-golang"
+)
+
+// SchemaURL is the JSON schema of flagd flag configurations
+const SchemaURL = "https://flagd.dev/schema/v0/flags.json"
+
+// Flag states of the flagd schema
+const (
+	StateEnabled  = "ENABLED"
+	StateDisabled = "DISABLED"
+)
+
+// defaultVariant is the variant holding a feature's default value
+const defaultVariant = "default"
+
+// Configuration is a flag configuration in flagd's schema
+type Configuration struct {
+	Schema string          `json:"$schema"`
+	Flags  map[string]Flag `json:"flags"`
+}
+
+// Flag is a flag definition in flagd's schema
+type Flag struct {
+	State          string                 `json:"state"`
+	Variants       map[string]interface{} `json:"variants"`
+	DefaultVariant string                 `json:"defaultVariant"`
+}
+
+// Export converts GrowthBook features into a flagd configuration. Every
+// feature becomes an enabled flag whose default variant is the feature's
+// default value. The values of its force rules and experiment variations are
+// listed as further variants, so flagd knows every value the flag can take.
+//
+// GrowthBook's targeting conditions and experiment bucketing have no flagd
+// equivalent the Go SDK can export (its conditions can't be serialized), so
+// flagd always serves the default variant. Use the GrowthBook provider itself,
+// or the ofrep package, where targeting matters. Features without a default
+// value are skipped, as flagd flags need one.
+func Export(features gb.FeatureMap) Configuration {
+	config := Configuration{Schema: SchemaURL, Flags: make(map[string]Flag, len(features))}
+	for key, feature := range features {
+		if feature == nil || feature.DefaultValue == nil {
+			continue
+		}
+		variants := map[string]interface{}{defaultVariant: feature.DefaultValue}
+		for i, rule := range feature.Rules {
+			if rule.Force != nil {
+				variants[ruleVariant(rule, i)] = rule.Force
+			}
+			for j, variation := range rule.Variations {
+				variants[fmt.Sprintf("%s-%d", ruleVariant(rule, i), j)] = variation
+			}
+		}
+		config.Flags[key] = Flag{
+			State:          StateEnabled,
+			Variants:       variants,
+			DefaultVariant: defaultVariant,
+		}
+	}
+	return config
+}
+
+// ruleVariant names the variants of a rule after its ID, its experiment key or
+// its position
+func ruleVariant(rule gb.FeatureRule, index int) string {
+	switch {
+	case rule.Id != "":
+		return rule.Id
+	case rule.Key != "":
+		return rule.Key
+	default:
+		return fmt.Sprintf("rule-%d", index)
+	}
+}
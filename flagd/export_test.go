@@ -0,0 +1,88 @@
+package flagd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+)
+
+// validateConfiguration checks a JSON configuration against the constraints
+// of flagd's flags.json schema: flags have an ENABLED or DISABLED state, a
+// non-empty variants object whose values share one JSON type, and a default
+// variant that is one of the variants.
+func validateConfiguration(data []byte) error {
+	var config struct {
+		Schema string `json:"$schema"`
+		Flags  map[string]struct {
+			State          *string                `json:"state"`
+			Variants       map[string]interface{} `json:"variants"`
+			DefaultVariant *string                `json:"defaultVariant"`
+		} `json:"flags"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return err
+	}
+	if config.Schema != SchemaURL {
+		return fmt.Errorf("unexpected $schema %q", config.Schema)
+	}
+	if config.Flags == nil {
+		return fmt.Errorf("missing flags")
+	}
+	for key, flag := range config.Flags {
+		if flag.State == nil || (*flag.State != StateEnabled && *flag.State != StateDisabled) {
+			return fmt.Errorf("flag %s: invalid state", key)
+		}
+		if len(flag.Variants) == 0 {
+			return fmt.Errorf("flag %s: no variants", key)
+		}
+		if flag.DefaultVariant == nil {
+			return fmt.Errorf("flag %s: missing defaultVariant", key)
+		}
+		if _, ok := flag.Variants[*flag.DefaultVariant]; !ok {
+			return fmt.Errorf("flag %s: defaultVariant %q isn't a variant", key, *flag.DefaultVariant)
+		}
+		kind := ""
+		for name, value := range flag.Variants {
+			valueKind := fmt.Sprintf("%T", value)
+			if kind != "" && valueKind != kind {
+				return fmt.Errorf("flag %s: variant %s is a %s, others are %s", key, name, valueKind, kind)
+			}
+			kind = valueKind
+		}
+	}
+	return nil
+}
+
+func TestExportValidatesAgainstSchema(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"new-checkout": {"defaultValue": false, "rules": [{"id": "nz", "condition": {"country": "NZ"}, "force": true}]},
+		"cta-text": {"defaultValue": "Buy", "rules": [{"key": "cta-exp", "variations": ["Buy", "Buy now"]}]},
+		"max-items": {"defaultValue": 20},
+		"theme": {"defaultValue": {"color": "blue"}},
+		"no-default": {"rules": [{"force": true}]}
+	}`))
+
+	config := Export(gbClient.Features())
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("Failed to marshal configuration: %v", err)
+	}
+	if err := validateConfiguration(data); err != nil {
+		t.Fatalf("Expected a valid flagd configuration, got %v: %s", err, data)
+	}
+
+	if len(config.Flags) != 4 {
+		t.Errorf("Expected 4 flags, without the one lacking a default value, got %d", len(config.Flags))
+	}
+	checkout := config.Flags["new-checkout"]
+	if checkout.Variants["default"] != false || checkout.Variants["nz"] != true {
+		t.Errorf("Expected the default and force rule variants, got %v", checkout.Variants)
+	}
+	cta := config.Flags["cta-text"]
+	if cta.Variants["cta-exp-0"] != "Buy" || cta.Variants["cta-exp-1"] != "Buy now" {
+		t.Errorf("Expected the experiment variations as variants, got %v", cta.Variants)
+	}
+}
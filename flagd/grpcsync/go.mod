@@ -0,0 +1,25 @@
+module github.com/growthbook/growthbook-openfeature-provider-go/flagd/grpcsync
+
+go 1.25.0
+
+require (
+	buf.build/gen/go/open-feature/flagd/grpc/go v1.6.2-20260505175803-41d9fe89b4ff.1
+	buf.build/gen/go/open-feature/flagd/protocolbuffers/go v1.36.11-20260505175803-41d9fe89b4ff.1
+	github.com/growthbook/growthbook-golang v0.2.1
+	github.com/growthbook/growthbook-openfeature-provider-go v0.0.0
+	github.com/open-feature/go-sdk v1.14.1
+	google.golang.org/grpc v1.81.0
+)
+
+require (
+	github.com/go-logr/logr v1.4.3 // indirect
+	github.com/tmaxmax/go-sse v0.10.0 // indirect
+	golang.org/x/exp v0.0.0-20240506185415-9bf2ced13842 // indirect
+	golang.org/x/net v0.57.0 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+	golang.org/x/text v0.40.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260706201446-f0a921348800 // indirect
+	google.golang.org/protobuf v1.36.11 // indirect
+)
+
+replace github.com/growthbook/growthbook-openfeature-provider-go => ../..
@@ -0,0 +1,105 @@
+// Package grpcsync serves the features loaded by a GrowthBook provider over
+// flagd's gRPC sync protocol (flagd.sync.v1.FlagSyncService), which flagd
+// consumes with its gRPC sync provider (--uri grpc://host:port). Unlike the
+// HTTP handler of the flagd package, which flagd polls, updates are pushed to
+// flagd as soon as the provider reports them.
+//
+// It's a module of its own, so the provider doesn't depend on gRPC and the
+// flagd protocol buffers unless this package is used.
+package grpcsync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"buf.build/gen/go/open-feature/flagd/grpc/go/flagd/sync/v1/syncv1grpc"
+	syncv1 "buf.build/gen/go/open-feature/flagd/protocolbuffers/go/flagd/sync/v1"
+	gbprovider "github.com/growthbook/growthbook-openfeature-provider-go"
+	"github.com/growthbook/growthbook-openfeature-provider-go/flagd"
+	"github.com/open-feature/go-sdk/openfeature"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server implements flagd's FlagSyncService with the features of a provider,
+// exported with flagd.Export. SyncFlags streams the configuration, then again
+// whenever the provider emits PROVIDER_CONFIGURATION_CHANGED, so the provider
+// needs change events enabled with WithChangeEvents for reloads to be pushed.
+// Selectors and provider IDs of requests are ignored: every flag is served.
+type Server struct {
+	syncv1grpc.UnimplementedFlagSyncServiceServer
+	provider *gbprovider.Provider
+}
+
+// NewServer creates a Server exporting the features of provider
+func NewServer(provider *gbprovider.Provider) *Server {
+	return &Server{provider: provider}
+}
+
+// Register registers the server on registrar, e.g. a *grpc.Server
+func (s *Server) Register(registrar grpc.ServiceRegistrar) {
+	syncv1grpc.RegisterFlagSyncServiceServer(registrar, s)
+}
+
+// SyncFlags streams the flag configuration, and an updated one whenever the
+// provider becomes ready or its features change, until the stream is done
+func (s *Server) SyncFlags(_ *syncv1.SyncFlagsRequest, stream grpc.ServerStreamingServer[syncv1.SyncFlagsResponse]) error {
+	events, unsubscribe := s.provider.Subscribe()
+	defer unsubscribe()
+
+	last := ""
+	send := func() error {
+		config, err := s.configuration()
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if config == last {
+			return nil
+		}
+		last = config
+		return stream.Send(&syncv1.SyncFlagsResponse{FlagConfiguration: config})
+	}
+	if err := send(); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if event.EventType != openfeature.ProviderReady && event.EventType != openfeature.ProviderConfigChange {
+				continue
+			}
+			// Every event exports the latest features, so events dropped
+			// while the stream was sending don't lose updates
+			if err := send(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// FetchAllFlags returns the current flag configuration
+func (s *Server) FetchAllFlags(context.Context, *syncv1.FetchAllFlagsRequest) (*syncv1.FetchAllFlagsResponse, error) {
+	config, err := s.configuration()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &syncv1.FetchAllFlagsResponse{FlagConfiguration: config}, nil
+}
+
+// configuration returns the provider's current features as a flagd
+// configuration in JSON
+func (s *Server) configuration() (string, error) {
+	data, err := json.Marshal(flagd.Export(s.provider.Snapshot().Features))
+	if err != nil {
+		return "", fmt.Errorf("failed to export flags: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,109 @@
+package grpcsync
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"buf.build/gen/go/open-feature/flagd/grpc/go/flagd/sync/v1/syncv1grpc"
+	syncv1 "buf.build/gen/go/open-feature/flagd/protocolbuffers/go/flagd/sync/v1"
+	gb "github.com/growthbook/growthbook-golang"
+	gbprovider "github.com/growthbook/growthbook-openfeature-provider-go"
+	"github.com/growthbook/growthbook-openfeature-provider-go/flagd"
+	"github.com/open-feature/go-sdk/openfeature"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// dialServer serves provider on an in-memory listener and returns a client
+func dialServer(t *testing.T, provider *gbprovider.Provider) syncv1grpc.FlagSyncServiceClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	NewServer(provider).Register(server)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to dial the server: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return syncv1grpc.NewFlagSyncServiceClient(conn)
+}
+
+// decodeConfiguration decodes a flag configuration, checking it names
+// flagd's schema
+func decodeConfiguration(t *testing.T, data string) flagd.Configuration {
+	t.Helper()
+	var config flagd.Configuration
+	if err := json.Unmarshal([]byte(data), &config); err != nil {
+		t.Fatalf("Expected a JSON configuration, got %v: %s", err, data)
+	}
+	if config.Schema != flagd.SchemaURL {
+		t.Errorf("Expected the flagd schema, got %q", config.Schema)
+	}
+	for key, flag := range config.Flags {
+		if _, ok := flag.Variants[flag.DefaultVariant]; !ok || flag.State != flagd.StateEnabled {
+			t.Errorf("Expected %s to be enabled with its default variant, got %+v", key, flag)
+		}
+	}
+	return config
+}
+
+func TestSyncFlagsPushesReloads(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{"banner": {"defaultValue": "v1"}}`))
+	provider := gbprovider.NewProvider(gbClient, false, gbprovider.WithChangeEvents(10*time.Millisecond))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	defer provider.Shutdown()
+	client := dialServer(t, provider)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := client.SyncFlags(ctx, &syncv1.SyncFlagsRequest{ProviderId: "test"})
+	if err != nil {
+		t.Fatalf("SyncFlags failed: %v", err)
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Expected the initial configuration, got %v", err)
+	}
+	if config := decodeConfiguration(t, first.FlagConfiguration); config.Flags["banner"].Variants["default"] != "v1" {
+		t.Errorf("Expected banner v1, got %+v", config.Flags)
+	}
+
+	// A reload is pushed without asking
+	_ = gbClient.SetJSONFeatures(`{"banner": {"defaultValue": "v2"}, "max-items": {"defaultValue": 20}}`)
+	update, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Expected the reloaded configuration, got %v", err)
+	}
+	config := decodeConfiguration(t, update.FlagConfiguration)
+	if config.Flags["banner"].Variants["default"] != "v2" || len(config.Flags) != 2 {
+		t.Errorf("Expected the reloaded flags, got %+v", config.Flags)
+	}
+}
+
+func TestFetchAllFlags(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"new-checkout": {"defaultValue": false, "rules": [{"id": "nz", "condition": {"country": "NZ"}, "force": true}]}
+	}`))
+	provider := gbprovider.NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	client := dialServer(t, provider)
+
+	resp, err := client.FetchAllFlags(context.Background(), &syncv1.FetchAllFlagsRequest{})
+	if err != nil {
+		t.Fatalf("FetchAllFlags failed: %v", err)
+	}
+	checkout := decodeConfiguration(t, resp.FlagConfiguration).Flags["new-checkout"]
+	if checkout.Variants["default"] != false || checkout.Variants["nz"] != true {
+		t.Errorf("Expected the default and force rule variants, got %v", checkout.Variants)
+	}
+}
@@ -0,0 +1,49 @@
+package flagd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	gbprovider "github.com/growthbook/growthbook-openfeature-provider-go"
+)
+
+// Handler serves the features of a provider as a flagd configuration, for
+// flagd's HTTP sync provider. Every request exports the features currently
+// loaded, so a reload is served on the next poll. Responses carry an ETag, and
+// requests whose If-None-Match matches it get 304 Not Modified.
+type Handler struct {
+	provider *gbprovider.Provider
+}
+
+// NewHandler creates a Handler exporting the features of provider
+func NewHandler(provider *gbprovider.Provider) *Handler {
+	return &Handler{provider: provider}
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := json.Marshal(Export(h.provider.Snapshot().Features))
+	if err != nil {
+		http.Error(w, "failed to export flags: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:8]) + `"`
+
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	//nolint:errcheck
+	w.Write(body)
+}
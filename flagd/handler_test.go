@@ -0,0 +1,81 @@
+package flagd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	gbprovider "github.com/growthbook/growthbook-openfeature-provider-go"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func fetchConfiguration(t *testing.T, url string, etag string) (int, string, Configuration) {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var config Configuration
+	if resp.StatusCode == http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		if err := validateConfiguration(body); err != nil {
+			t.Fatalf("Expected a valid flagd configuration, got %v: %s", err, body)
+		}
+		_ = json.Unmarshal(body, &config)
+	}
+	return resp.StatusCode, resp.Header.Get("ETag"), config
+}
+
+func TestHandlerServesReloadedFeatures(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{"banner": {"defaultValue": "v1"}}`))
+	provider := gbprovider.NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	server := httptest.NewServer(NewHandler(provider))
+	defer server.Close()
+
+	status, etag, config := fetchConfiguration(t, server.URL, "")
+	if status != http.StatusOK || config.Flags["banner"].Variants["default"] != "v1" {
+		t.Fatalf("Expected banner v1, got status %d and %v", status, config.Flags)
+	}
+	if status, _, _ := fetchConfiguration(t, server.URL, etag); status != http.StatusNotModified {
+		t.Errorf("Expected 304 for an unchanged configuration, got %d", status)
+	}
+
+	_ = gbClient.SetJSONFeatures(`{"banner": {"defaultValue": "v2"}, "added": {"defaultValue": true}}`)
+
+	status, newETag, config := fetchConfiguration(t, server.URL, etag)
+	if status != http.StatusOK {
+		t.Fatalf("Expected the reloaded configuration, got status %d", status)
+	}
+	if newETag == etag {
+		t.Error("Expected the ETag to change on reload")
+	}
+	if config.Flags["banner"].Variants["default"] != "v2" || config.Flags["added"].Variants["default"] != true {
+		t.Errorf("Expected the reloaded flags, got %v", config.Flags)
+	}
+}
+
+func TestHandlerRejectsOtherMethods(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background())
+	server := httptest.NewServer(NewHandler(gbprovider.NewProvider(gbClient, false)))
+	defer server.Close()
+
+	resp, err := http.Post(server.URL, "application/json", nil)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("Expected 405, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,76 @@
+package growthbook
+
+import (
+	"context"
+
+	gb "github.com/growthbook/growthbook-golang"
+)
+
+// forcedVariationsKey is the context key of variations forced with ForceVariation
+type forcedVariationsKey struct{}
+
+// ForceVariation returns a copy of ctx that pins the experiments of flag to
+// the variation at index for evaluations made with it, for example when a QA
+// engineer requests a variation through a header. It's applied to every
+// experiment rule of the flag and takes precedence over WithForcedVariations.
+func ForceVariation(ctx context.Context, flag string, index int) context.Context {
+	forced := map[string]int{}
+	if existing, ok := ctx.Value(forcedVariationsKey{}).(map[string]int); ok {
+		for k, v := range existing {
+			forced[k] = v
+		}
+	}
+	forced[flag] = index
+	return context.WithValue(ctx, forcedVariationsKey{}, forced)
+}
+
+// withForcedVariations returns a child of client that assigns the variations
+// forced for flag with WithForcedVariations or ForceVariation. The forced
+// variations replace any configured on the GrowthBook client itself.
+func (p *Provider) withForcedVariations(ctx context.Context, client *gb.Client, flag string) *gb.Client {
+	byFlag, _ := ctx.Value(forcedVariationsKey{}).(map[string]int)
+	index, flagForced := byFlag[flag]
+	if len(p.forcedVariations) == 0 && !flagForced {
+		return client
+	}
+
+	forced := make(gb.ForcedVariationsMap, len(p.forcedVariations))
+	for key, variation := range p.forcedVariations {
+		forced[key] = variation
+	}
+	if flagForced {
+		if feature := client.Features()[flag]; feature != nil {
+			for _, rule := range feature.Rules {
+				if len(rule.Variations) == 0 {
+					continue
+				}
+				key := rule.Key
+				if key == "" {
+					key = flag
+				}
+				forced[key] = index
+			}
+		}
+	}
+
+	child, _ := client.WithForcedVariations(forced)
+	return child
+}
+
+// isForcedResult reports whether an experiment assigned its variation without
+// hashing, which only happens when the variation was forced
+func isForcedResult(feature *gb.FeatureResult) bool {
+	result := feature.ExperimentResult
+	return result != nil && result.InExperiment && !result.HashUsed
+}
+
+// forcedResult returns a copy of the result injected for flag with
+// WithForcedResults, or nil if there's none
+func (p *Provider) forcedResult(flag string) *gb.FeatureResult {
+	forced, ok := p.forcedResults[flag]
+	if !ok || forced == nil {
+		return nil
+	}
+	result := *forced
+	return &result
+}
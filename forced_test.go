@@ -0,0 +1,122 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+const forcedFeatures = `{
+	"checkout": {
+		"defaultValue": "none",
+		"rules": [{"key": "checkout-exp", "variations": ["control", "treatment", "other"]}]
+	}
+}`
+
+func TestWithForcedVariations(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(forcedFeatures))
+	provider := NewProvider(gbClient, false, WithForcedVariations(map[string]int{"checkout-exp": 1}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.StringEvaluation(context.Background(), "checkout", "fallback", openfeature.FlattenedContext{"id": "user-1"})
+	if result.Value != "treatment" {
+		t.Errorf("Expected forced variation 'treatment', got %q", result.Value)
+	}
+	if result.FlagMetadata["forcedVariation"] != true {
+		t.Errorf("Expected forcedVariation metadata, got %v", result.FlagMetadata)
+	}
+}
+
+func TestForceVariationPerEvaluation(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(forcedFeatures))
+	provider := NewProvider(gbClient, false, WithForcedVariations(map[string]int{"checkout-exp": 2}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	ctx := ForceVariation(context.Background(), "checkout", 1)
+	result := provider.StringEvaluation(ctx, "checkout", "fallback", nil)
+	if result.Value != "treatment" {
+		t.Errorf("Expected per-evaluation forced variation 'treatment', got %q", result.Value)
+	}
+	if result.FlagMetadata["forcedVariation"] != true {
+		t.Errorf("Expected forcedVariation metadata, got %v", result.FlagMetadata)
+	}
+
+	// Other evaluations keep the provider-wide forced variation
+	result = provider.StringEvaluation(context.Background(), "checkout", "fallback", nil)
+	if result.Value != "other" {
+		t.Errorf("Expected provider forced variation 'other', got %q", result.Value)
+	}
+}
+
+func TestForcedVariationMetadataAbsentWhenHashed(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(forcedFeatures))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.StringEvaluation(context.Background(), "checkout", "fallback", openfeature.FlattenedContext{"id": "user-1"})
+	if _, ok := result.FlagMetadata["forcedVariation"]; ok {
+		t.Errorf("Expected no forcedVariation metadata for a hashed assignment, got %v", result.FlagMetadata)
+	}
+}
+
+func TestForcedResults(t *testing.T) {
+	coverage := 0.5
+	provider := setupTestProvider(WithForcedResults(map[string]*gb.FeatureResult{
+		"bool-flag":   {Value: false, On: false, Off: true, Source: "custom-source", RuleId: "qa-rule"},
+		"string-flag": {Value: "from default", Source: gb.DefaultValueResultSource},
+		"injected": {
+			Value:            "treatment",
+			On:               true,
+			Source:           gb.ExperimentResultSource,
+			Experiment:       &gb.Experiment{Key: "checkout-test", Coverage: &coverage},
+			ExperimentResult: &gb.ExperimentResult{InExperiment: true, Key: "trt", HashAttribute: "id"},
+		},
+	}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	ctx := context.Background()
+
+	custom := provider.BooleanEvaluation(ctx, "bool-flag", true, nil)
+	if custom.Value || custom.Reason != openfeature.TargetingMatchReason {
+		t.Errorf("Expected the forced value with TARGETING_MATCH, got %v (%s)", custom.Value, custom.Reason)
+	}
+	if custom.Variant != "qa-rule" || custom.FlagMetadata["source"] != "custom-source" {
+		t.Errorf("Expected the forced rule and source, got %q %v", custom.Variant, custom.FlagMetadata)
+	}
+
+	if result := provider.StringEvaluation(ctx, "string-flag", "", nil); result.Reason != openfeature.DefaultReason {
+		t.Errorf("Expected a defaultValue source to map to DEFAULT, got %s", result.Reason)
+	}
+
+	// Forced flags don't need to exist
+	experiment := provider.StringEvaluation(ctx, "injected", "", nil)
+	if experiment.Value != "treatment" || experiment.Variant != "trt" {
+		t.Errorf("Expected the forced experiment variation, got %q (variant %q, %v)", experiment.Value, experiment.Variant, experiment.Error())
+	}
+	if experiment.FlagMetadata["experiment"] != true || experiment.FlagMetadata["hashAttribute"] != "id" || experiment.FlagMetadata["coverage"] != coverage {
+		t.Errorf("Expected the experiment metadata, got %v", experiment.FlagMetadata)
+	}
+
+	// Other flags are evaluated
+	if result := provider.IntEvaluation(ctx, "int-flag", 0, nil); result.Value != 42 {
+		t.Errorf("Expected other flags to be evaluated, got %d", result.Value)
+	}
+}
+
+func TestForcedResultsBypassHashAttributeShortCircuit(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(experimentOnlyFeatures))
+	provider := NewProvider(gbClient, false,
+		WithHashAttributeShortCircuit(true),
+		WithForcedResults(map[string]*gb.FeatureResult{"exp-flag": {Value: "forced"}}),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.StringEvaluation(context.Background(), "exp-flag", "default", openfeature.FlattenedContext{"country": "NZ"})
+	if result.Value != "forced" {
+		t.Errorf("Expected the forced result without a hash attribute, got %q (%v)", result.Value, result.FlagMetadata)
+	}
+	if _, ok := result.FlagMetadata["skippedNoHashAttribute"]; ok {
+		t.Errorf("Expected the forced result not to be short-circuited, got %v", result.FlagMetadata)
+	}
+}
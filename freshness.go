@@ -0,0 +1,48 @@
+package growthbook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// requireFreshKey is the context key marking evaluations made with RequireFresh
+type requireFreshKey struct{}
+
+// RequireFresh returns a copy of ctx for evaluations that must not be served
+// from stale features, for critical flags. When the features were last
+// refreshed longer ago than the WithFlagStaleness TTL, such evaluations
+// refresh them first if a feature loader is set (see WithFeatureLoader), and
+// otherwise, or if they're still stale, fail with a General error and return
+// the default value. They bypass the evaluation cache.
+func RequireFresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requireFreshKey{}, true)
+}
+
+// requiresFresh reports whether ctx is marked with RequireFresh
+func requiresFresh(ctx context.Context) bool {
+	return ctx.Value(requireFreshKey{}) != nil
+}
+
+// checkFresh returns the error detail of an evaluation requiring fresh
+// features while they're stale, or nil if it may go on
+func (p *Provider) checkFresh(ctx context.Context, flag string) *openfeature.ProviderResolutionDetail {
+	if !requiresFresh(ctx) || !p.isStale() {
+		return nil
+	}
+	if p.featureLoader != nil {
+		if err := p.Refresh(ctx); err != nil {
+			p.log().Warn("Failed to refresh stale features for an evaluation requiring fresh features", "flag", flag, "error", err)
+		} else if !p.isStale() {
+			return nil
+		}
+	}
+	return &openfeature.ProviderResolutionDetail{
+		ResolutionError: openfeature.NewGeneralResolutionError(fmt.Sprintf(
+			"flag '%s' requires fresh features, but they were last refreshed %s ago",
+			flag, p.now().Sub(p.lastRefresh()).Round(time.Second))),
+		Reason: openfeature.ErrorReason,
+	}
+}
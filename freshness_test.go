@@ -0,0 +1,63 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestRequireFreshFailsOnStaleFeatures(t *testing.T) {
+	clock := newFakeClock()
+	server := newStalenessServer(t)
+	provider, _ := setupStalenessProvider(t, server, time.Hour, clock,
+		WithFlagStaleness(time.Minute), WithEvaluationCache(time.Hour))
+
+	if result := provider.BooleanEvaluation(RequireFresh(context.Background()), "bool-flag", false, nil); result.Error() != nil {
+		t.Errorf("Expected fresh features to be served, got %v", result.Error())
+	}
+	provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+
+	// No fetch happens within the TTL
+	clock.Advance(2 * time.Minute)
+
+	result := provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+	if !result.Value || result.Reason != openfeature.CachedReason {
+		t.Errorf("Expected the cached value to be served, got %v (%s)", result.Value, result.Reason)
+	}
+
+	result = provider.BooleanEvaluation(RequireFresh(context.Background()), "bool-flag", false, nil)
+	if result.Value {
+		t.Error("Expected the default value when fresh features are required")
+	}
+	if code := result.ResolutionDetail().ErrorCode; code != openfeature.GeneralCode {
+		t.Errorf("Expected a General error, got %q", code)
+	}
+}
+
+func TestRequireFreshRefreshesWithFeatureLoader(t *testing.T) {
+	clock := newFakeClock()
+	tracker := NewFetchTracker(nil)
+	loads := 0
+	loader := func(ctx context.Context) (json.RawMessage, error) {
+		loads++
+		return json.RawMessage(`{"banner": {"defaultValue": "fresh"}}`), nil
+	}
+	gbClient, _ := gb.NewClient(context.Background())
+	provider := NewProvider(gbClient, false, WithFeatureLoader(loader), WithFetchTracker(tracker),
+		WithFlagStaleness(time.Minute), WithClock(clock.Now))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	clock.Advance(2 * time.Minute)
+
+	result := provider.StringEvaluation(RequireFresh(context.Background()), "banner", "default", nil)
+	if result.Value != "fresh" || result.Error() != nil {
+		t.Errorf("Expected the refreshed value, got %q (%v)", result.Value, result.Error())
+	}
+	if loads != 2 {
+		t.Errorf("Expected a synchronous refresh, got %d loads", loads)
+	}
+}
@@ -0,0 +1,65 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+
+	gb "github.com/growthbook/growthbook-golang"
+)
+
+// groupMembershipClient returns a client evaluating against attrs whose saved
+// groups follow the membership set with WithGroupMembership. A group the user
+// is a member of lists every attribute value of the evaluation, so any
+// $inGroup condition on it matches whatever attribute it checks, and any other
+// group is empty.
+func (p *Provider) groupMembershipClient(ctx context.Context, attrs gb.Attributes) (*gb.Client, error) {
+	values := attributeValues(attrs, nil)
+	groups := make(map[string][]interface{}, len(p.groupMembership))
+	for group, member := range p.groupMembership {
+		if member {
+			groups[group] = values
+		} else {
+			groups[group] = []interface{}{}
+		}
+	}
+
+	// The client's saved groups can only be given in an API response, as
+	// their type is internal to the GrowthBook SDK
+	groupsJSON, err := json.Marshal(map[string]interface{}{"savedGroups": groups})
+	if err != nil {
+		return nil, err
+	}
+	var resp gb.FeatureApiResponse
+	if err := json.Unmarshal(groupsJSON, &resp); err != nil {
+		return nil, err
+	}
+	resp.Features = p.gbClient.Features()
+
+	client, err := gb.NewClient(ctx, gb.WithAttributes(attrs), gb.WithLogger(p.log()))
+	if err != nil {
+		return nil, err
+	}
+	if err := client.UpdateFromApiResponse(&resp); err != nil {
+		return nil, err
+	}
+	return p.withTracking(client), nil
+}
+
+// attributeValues appends the scalar values found in attrs, including those of
+// nested attributes, to values
+func attributeValues(attrs map[string]interface{}, values []interface{}) []interface{} {
+	for _, v := range attrs {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			values = attributeValues(v, values)
+		case []interface{}:
+			values = append(values, v...)
+		default:
+			values = append(values, v)
+		}
+	}
+	if values == nil {
+		values = []interface{}{}
+	}
+	return values
+}
@@ -0,0 +1,95 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+const groupGatedFeatures = `{
+	"beta-banner": {
+		"defaultValue": false,
+		"rules": [{"condition": {"id": {"$inGroup": "beta-testers"}}, "force": true}]
+	},
+	"non-beta-banner": {
+		"defaultValue": false,
+		"rules": [{"condition": {"company.id": {"$notInGroup": "beta-testers"}}, "force": true}]
+	}
+}`
+
+func TestGroupMembershipOverride(t *testing.T) {
+	evalCtx := openfeature.FlattenedContext{"id": "user-123", "company": map[string]interface{}{"id": "acme"}}
+
+	for _, member := range []bool{true, false} {
+		gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(groupGatedFeatures))
+		provider := NewProvider(gbClient, false, WithGroupMembership(map[string]bool{"beta-testers": member}))
+		_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+		inGroup := provider.BooleanEvaluation(context.Background(), "beta-banner", false, evalCtx)
+		if inGroup.Value != member {
+			t.Errorf("Expected beta-banner to be %v with membership %v, got %v", member, member, inGroup.Value)
+		}
+		notInGroup := provider.BooleanEvaluation(context.Background(), "non-beta-banner", false, evalCtx)
+		if notInGroup.Value != !member {
+			t.Errorf("Expected non-beta-banner to be %v with membership %v, got %v", !member, member, notInGroup.Value)
+		}
+	}
+}
+
+func TestGroupMembershipUnsetUsesClientGroups(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(groupGatedFeatures))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.BooleanEvaluation(context.Background(), "beta-banner", false, openfeature.FlattenedContext{"id": "user-123"})
+	if result.Value {
+		t.Error("Expected beta-banner to be off without the group defined")
+	}
+}
+
+// BenchmarkLargeSavedGroup measures $inGroup conditions on a saved group of
+// many IDs. GrowthBook checks membership with a linear scan of the group, and
+// evaluates conditions internally, so the provider can't cache memberships;
+// the evaluation cache avoids re-running them for repeated evaluations.
+func BenchmarkLargeSavedGroup(b *testing.B) {
+	ids := make([]string, 100000)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("user-%d", i)
+	}
+	resp, _ := json.Marshal(map[string]interface{}{
+		"features":    json.RawMessage(groupGatedFeatures),
+		"savedGroups": map[string][]string{"beta-testers": ids},
+	})
+	// The last ID is the slowest to find
+	evalCtx := openfeature.FlattenedContext{"id": ids[len(ids)-1]}
+
+	for _, bench := range []struct {
+		name    string
+		options []interface{}
+	}{
+		{"Uncached", nil},
+		{"EvaluationCache", []interface{}{WithEvaluationCache(time.Minute)}},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			gbClient, _ := gb.NewClient(context.Background())
+			if err := gbClient.UpdateFromApiResponseJSON(string(resp)); err != nil {
+				b.Fatalf("Failed to load the saved group: %v", err)
+			}
+			provider := NewProvider(gbClient, append([]interface{}{false}, bench.options...)...)
+			_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+			if !provider.BooleanEvaluation(context.Background(), "beta-banner", false, evalCtx).Value {
+				b.Fatal("Expected the user to be in the saved group")
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				provider.BooleanEvaluation(context.Background(), "beta-banner", false, evalCtx)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package growthbook
+
+import "time"
+
+// logHealth logs the provider's feature count, data source mode and last
+// update time at the health log interval, until done is closed. A count of
+// zero in these logs points at a data source that silently loaded nothing.
+func (p *Provider) logHealth(done <-chan struct{}) {
+	ticker := time.NewTicker(p.healthInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		info := p.Info()
+		args := []any{
+			"featureCount", info.FeatureCount,
+			"dataSourceMode", info.DataSourceMode,
+			"lastUpdated", info.LastLoaded,
+		}
+		if !info.LastLoaded.IsZero() {
+			args = append(args, "sinceUpdate", p.now().Sub(info.LastLoaded))
+		}
+		p.log().Info("GrowthBook provider health", args...)
+	}
+}
@@ -0,0 +1,81 @@
+package growthbook
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// recordHandler is a slog.Handler passing the records it handles to a channel
+type recordHandler struct {
+	records chan slog.Record
+}
+
+func (h *recordHandler) Enabled(context.Context, slog.Level) bool { return true }
+func (h *recordHandler) WithAttrs([]slog.Attr) slog.Handler       { return h }
+func (h *recordHandler) WithGroup(string) slog.Handler            { return h }
+
+func (h *recordHandler) Handle(_ context.Context, record slog.Record) error {
+	select {
+	case h.records <- record:
+	default:
+	}
+	return nil
+}
+
+func recordAttrs(record slog.Record) map[string]slog.Value {
+	attrs := make(map[string]slog.Value)
+	record.Attrs(func(attr slog.Attr) bool {
+		attrs[attr.Key] = attr.Value
+		return true
+	})
+	return attrs
+}
+
+func TestHealthLogFiresUntilShutdown(t *testing.T) {
+	clock := newFakeClock()
+	handler := &recordHandler{records: make(chan slog.Record, 100)}
+	provider := setupTestProvider(
+		WithHealthLogInterval(10*time.Millisecond),
+		WithDataSourceMode(DataSourceNone),
+		WithClock(clock.Now),
+		WithLogger(slog.New(handler)),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	loaded := clock.Now()
+	clock.Advance(time.Minute)
+
+	select {
+	case record := <-handler.records:
+		if record.Level != slog.LevelInfo || record.Message != "GrowthBook provider health" {
+			t.Errorf("Expected an info health log, got %v %q", record.Level, record.Message)
+		}
+		attrs := recordAttrs(record)
+		if attrs["featureCount"].Int64() != int64(len(provider.GetClient().Features())) {
+			t.Errorf("Expected the feature count to be logged, got %v", attrs["featureCount"])
+		}
+		if attrs["dataSourceMode"].String() != string(DataSourceNone) {
+			t.Errorf("Expected the data source mode to be logged, got %v", attrs["dataSourceMode"])
+		}
+		if !attrs["lastUpdated"].Time().Equal(loaded) {
+			t.Errorf("Expected the last update time %v, got %v", loaded, attrs["lastUpdated"])
+		}
+		if attrs["sinceUpdate"].Duration() != time.Minute {
+			t.Errorf("Expected a minute since the update, got %v", attrs["sinceUpdate"])
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for a health log")
+	}
+
+	provider.Shutdown()
+	for len(handler.records) > 0 {
+		<-handler.records
+	}
+	time.Sleep(50 * time.Millisecond)
+	if len(handler.records) > 0 {
+		t.Errorf("Expected no health logs after Shutdown, got %d", len(handler.records))
+	}
+}
@@ -0,0 +1,95 @@
+package growthbook
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// EvaluationRecord describes an evaluation kept by WithEvaluationHistory
+type EvaluationRecord struct {
+	Flag string
+	// AttributesFingerprint identifies the attributes the flag was evaluated
+	// against without retaining them. Evaluations with equal attributes have
+	// equal fingerprints.
+	AttributesFingerprint string
+	Value                 interface{}
+	Reason                openfeature.Reason
+	ErrorCode             openfeature.ErrorCode
+	Timestamp             time.Time
+}
+
+// evaluationHistory is a fixed-size ring buffer of evaluation records
+type evaluationHistory struct {
+	mu      sync.Mutex
+	records []EvaluationRecord
+	next    int  // Index the next record is written to
+	full    bool // Whether records has wrapped around
+}
+
+func newEvaluationHistory(size int) *evaluationHistory {
+	return &evaluationHistory{records: make([]EvaluationRecord, size)}
+}
+
+// add records an evaluation, overwriting the oldest one if the buffer is full
+func (h *evaluationHistory) add(record EvaluationRecord) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records[h.next] = record
+	h.next = (h.next + 1) % len(h.records)
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+// list returns the recorded evaluations, oldest first
+func (h *evaluationHistory) list() []EvaluationRecord {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.full {
+		return append([]EvaluationRecord(nil), h.records[:h.next]...)
+	}
+	records := make([]EvaluationRecord, 0, len(h.records))
+	records = append(records, h.records[h.next:]...)
+	return append(records, h.records[:h.next]...)
+}
+
+// RecentEvaluations returns the most recent evaluations, oldest first, if
+// WithEvaluationHistory is enabled. It's meant for debug pages during
+// incidents.
+func (p *Provider) RecentEvaluations() []EvaluationRecord {
+	if p.history == nil {
+		return nil
+	}
+	return p.history.list()
+}
+
+// recordEvaluation adds an evaluation to the history, if it's enabled
+func (p *Provider) recordEvaluation(flag string, value interface{}, evalCtx openfeature.FlattenedContext, detail openfeature.ProviderResolutionDetail) {
+	if p.history == nil {
+		return
+	}
+	p.history.add(EvaluationRecord{
+		Flag:                  flag,
+		AttributesFingerprint: fingerprint(p.buildAttributes(evalCtx)),
+		Value:                 value,
+		Reason:                detail.Reason,
+		ErrorCode:             detail.ResolutionDetail().ErrorCode,
+		Timestamp:             p.now(),
+	})
+}
+
+// fingerprint returns a short stable hash of attrs
+func fingerprint(attrs map[string]interface{}) string {
+	// Map keys are marshaled in sorted order, so the encoding is stable
+	encoded, err := json.Marshal(attrs)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,67 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestRecentEvaluationsKeepsMostRecentInOrder(t *testing.T) {
+	clock := newFakeClock()
+	provider := setupTestProvider(WithEvaluationHistory(3), WithClock(clock.Now))
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	flags := []string{"bool-flag", "string-flag", "int-flag", "number-flag", "non-existent-flag"}
+	for _, flag := range flags {
+		clock.Advance(time.Second)
+		provider.ObjectEvaluation(context.Background(), flag, nil, openfeature.FlattenedContext{"id": "user-1"})
+	}
+
+	records := provider.RecentEvaluations()
+	if len(records) != 3 {
+		t.Fatalf("Expected 3 records, got %d", len(records))
+	}
+	for i, expected := range flags[2:] {
+		if records[i].Flag != expected {
+			t.Errorf("Expected record %d to be %s, got %s", i, expected, records[i].Flag)
+		}
+	}
+	if !records[0].Timestamp.Before(records[2].Timestamp) {
+		t.Errorf("Expected records to be ordered oldest first, got %v and %v", records[0].Timestamp, records[2].Timestamp)
+	}
+	last := records[2]
+	if last.Reason != openfeature.ErrorReason || last.ErrorCode != openfeature.FlagNotFoundCode {
+		t.Errorf("Expected the missing flag to be recorded as not found, got %s (%s)", last.Reason, last.ErrorCode)
+	}
+	if records[0].AttributesFingerprint == "" || records[0].AttributesFingerprint != records[1].AttributesFingerprint {
+		t.Errorf("Expected equal attributes to share a fingerprint, got %q and %q", records[0].AttributesFingerprint, records[1].AttributesFingerprint)
+	}
+}
+
+func TestRecentEvaluationsFingerprintsDiffer(t *testing.T) {
+	provider := setupTestProvider(WithEvaluationHistory(10))
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	provider.BooleanEvaluation(context.Background(), "bool-flag", false, openfeature.FlattenedContext{"id": "user-1"})
+	provider.BooleanEvaluation(context.Background(), "bool-flag", false, openfeature.FlattenedContext{"id": "user-2"})
+
+	records := provider.RecentEvaluations()
+	if len(records) != 2 || records[0].AttributesFingerprint == records[1].AttributesFingerprint {
+		t.Errorf("Expected different attributes to have different fingerprints, got %+v", records)
+	}
+	if records[0].Value != true {
+		t.Errorf("Expected recorded value true, got %v", records[0].Value)
+	}
+}
+
+func TestRecentEvaluationsDisabledByDefault(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+	provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+
+	if records := provider.RecentEvaluations(); records != nil {
+		t.Errorf("Expected no history without WithEvaluationHistory, got %v", records)
+	}
+}
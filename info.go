@@ -0,0 +1,102 @@
+package growthbook
+
+import "time"
+
+// DataSourceMode describes how the GrowthBook client receives feature updates.
+type DataSourceMode string
+
+const (
+	// DataSourceNone means features are provided in-memory and never refreshed.
+	DataSourceNone DataSourceMode = "none"
+	// DataSourceSSE means features are streamed using Server-Sent Events.
+	DataSourceSSE DataSourceMode = "sse"
+	// DataSourcePoll means features are refreshed by periodic polling.
+	DataSourcePoll DataSourceMode = "poll"
+	// DataSourceUnknown means a data source is used but its kind wasn't specified.
+	DataSourceUnknown DataSourceMode = "unknown"
+)
+
+// Info contains diagnostic information about the provider and its loaded features.
+type Info struct {
+	// DataSourceMode is the kind of data source the client uses. It's observed
+	// by the fetch tracker if one is configured, or else taken from
+	// WithDataSourceMode (DataSourceUnknown if neither is available).
+	DataSourceMode DataSourceMode
+	// LastLoaded is the time of the last successful fetch seen by the fetch
+	// tracker, or the time Init loaded features without one (zero if never)
+	LastLoaded time.Time
+	// FeatureCount is the number of features currently known to the client
+	FeatureCount int
+}
+
+// Info returns diagnostic information about the provider, useful when
+// debugging stale flags. It doesn't change the provider's state.
+func (p *Provider) Info() Info {
+	mode := p.dataSourceMode
+	if p.fetchTracker != nil {
+		if observed := p.fetchTracker.Mode(); observed != "" {
+			mode = observed
+		}
+	}
+
+	return Info{
+		DataSourceMode: mode,
+		LastLoaded:     p.lastRefresh(),
+		FeatureCount:   len(p.gbClient.Features()),
+	}
+}
+
+// Capabilities reports which optional capabilities are enabled on a provider,
+// for conditional integration tests and operator dashboards.
+type Capabilities struct {
+	// Events is true because the provider always implements
+	// openfeature.EventHandler
+	Events bool
+	// ChangeEvents reports whether PROVIDER_CONFIGURATION_CHANGED events are
+	// emitted (WithChangeEvents)
+	ChangeEvents bool
+	// StaleDetection reports whether stale features can be detected, which
+	// needs both WithFlagStaleness and a fetch tracker
+	StaleDetection bool
+	// Tracking reports whether experiment exposures are reported
+	// (WithTrackingCallback)
+	Tracking bool
+	// RemoteEval reports whether flags are evaluated remotely (WithRemoteEval)
+	RemoteEval bool
+	// Caching reports whether evaluation results are cached
+	// (WithEvaluationCache)
+	Caching bool
+	// DevMode reports whether dev mode overrides are honored (WithDevMode)
+	DevMode bool
+	// KillSwitchFlag is the flag gating all evaluations, if any
+	KillSwitchFlag string
+	// FallbackProvider reports whether unresolved flags are delegated to a
+	// fallback provider (WithFallbackProvider)
+	FallbackProvider bool
+	// Metrics, History and AuditLog report whether evaluations are counted,
+	// kept for RecentEvaluations and written to an audit log
+	Metrics  bool
+	History  bool
+	AuditLog bool
+	// DataSourceMode is the mode reported by Info
+	DataSourceMode DataSourceMode
+}
+
+// Describe reports the optional capabilities enabled on this provider
+func (p *Provider) Describe() Capabilities {
+	return Capabilities{
+		Events:           true,
+		ChangeEvents:     p.changeInterval > 0,
+		StaleDetection:   p.staleTTL > 0 && p.fetchTracker != nil,
+		Tracking:         p.trackingCallback != nil,
+		RemoteEval:       p.remoteEval,
+		Caching:          p.cache != nil,
+		DevMode:          p.devMode,
+		KillSwitchFlag:   p.killSwitchFlag,
+		FallbackProvider: p.fallbackProvider != nil,
+		Metrics:          p.currentMetrics() != nil,
+		History:          p.history != nil,
+		AuditLog:         p.auditLog != nil,
+		DataSourceMode:   p.Info().DataSourceMode,
+	}
+}
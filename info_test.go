@@ -0,0 +1,155 @@
+package growthbook
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// newTestFeatureServer starts an HTTP server that serves features JSON in the
+// GrowthBook features API format.
+func newTestFeatureServer(t *testing.T, featuresJSON string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"status": 200, "features": %s, "dateUpdated": "2024-01-01T00:00:00Z"}`, featuresJSON)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestInfoWithJSONFeatures(t *testing.T) {
+	provider := setupTestProvider()
+
+	info := provider.Info()
+	if info.DataSourceMode != DataSourceNone {
+		t.Errorf("Expected data source mode %q, got %q", DataSourceNone, info.DataSourceMode)
+	}
+	if !info.LastLoaded.IsZero() {
+		t.Errorf("Expected zero last loaded time before init, got %v", info.LastLoaded)
+	}
+
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	info = provider.Info()
+	if info.LastLoaded.IsZero() {
+		t.Error("Expected last loaded time to be set after init")
+	}
+	if info.FeatureCount != 6 {
+		t.Errorf("Expected 6 features, got %d", info.FeatureCount)
+	}
+}
+
+func TestInfoWithDataSource(t *testing.T) {
+	server := newTestFeatureServer(t, `{"flag-a": {"defaultValue": true}, "flag-b": {"defaultValue": 1}}`)
+
+	gbClient, err := gb.NewClient(
+		context.Background(),
+		gb.WithApiHost(server.URL),
+		gb.WithClientKey("sdk-test"),
+		gb.WithPollDataSource(time.Minute),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create GrowthBook client: %v", err)
+	}
+
+	provider := NewProvider(gbClient, 5*time.Second, WithDataSourceMode(DataSourcePoll))
+	defer provider.Shutdown()
+
+	if err := provider.Init(openfeature.NewEvaluationContext("test-user", nil)); err != nil {
+		t.Fatalf("Provider initialization failed: %v", err)
+	}
+
+	info := provider.Info()
+	if info.DataSourceMode != DataSourcePoll {
+		t.Errorf("Expected data source mode %q, got %q", DataSourcePoll, info.DataSourceMode)
+	}
+	if info.LastLoaded.IsZero() {
+		t.Error("Expected last loaded time to be set after init")
+	}
+	if info.FeatureCount != 2 {
+		t.Errorf("Expected 2 features, got %d", info.FeatureCount)
+	}
+}
+
+func TestInfoDefaultsToUnknownDataSource(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background())
+	provider := NewProvider(gbClient)
+
+	if mode := provider.Info().DataSourceMode; mode != DataSourceUnknown {
+		t.Errorf("Expected data source mode %q, got %q", DataSourceUnknown, mode)
+	}
+}
+
+func TestInfoObservesFetchTracker(t *testing.T) {
+	clock := newFakeClock()
+	server := newStalenessServer(t)
+	provider, tracker := setupStalenessProvider(t, server, 10*time.Millisecond, clock)
+
+	clock.Advance(time.Minute)
+	waitForFetchAfter(t, tracker, clock.Now().Add(-time.Second))
+
+	info := provider.Info()
+	if info.DataSourceMode != DataSourcePoll {
+		t.Errorf("Expected observed data source mode %q, got %q", DataSourcePoll, info.DataSourceMode)
+	}
+	if !info.LastLoaded.Equal(clock.Now()) {
+		t.Errorf("Expected last loaded time %v from the latest 304 fetch, got %v", clock.Now(), info.LastLoaded)
+	}
+	if provider.Status() != openfeature.ReadyState {
+		t.Errorf("Expected Info not to change provider state, got %v", provider.Status())
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	plain := setupTestProvider().Describe()
+	expected := Capabilities{Events: true, DataSourceMode: DataSourceNone}
+	if plain != expected {
+		t.Errorf("Expected capabilities %+v, got %+v", expected, plain)
+	}
+
+	tracker := NewFetchTracker(nil)
+	full := setupTestProvider(
+		WithChangeEvents(time.Minute),
+		WithFlagStaleness(time.Minute),
+		WithFetchTracker(tracker),
+		WithTrackingCallback(func(context.Context, *gb.Experiment, *gb.ExperimentResult) {}),
+		WithRemoteEval(true),
+		WithEvaluationCache(time.Minute),
+		WithDevMode(true),
+		WithKillSwitchFlag("kill-switch"),
+		WithFallbackProvider(setupTestProvider()),
+		WithEvaluationHistory(10),
+		WithAuditLog(io.Discard),
+		WithDataSourceMode(DataSourcePoll),
+	).Describe()
+	expected = Capabilities{
+		Events:           true,
+		ChangeEvents:     true,
+		StaleDetection:   true,
+		Tracking:         true,
+		RemoteEval:       true,
+		Caching:          true,
+		DevMode:          true,
+		KillSwitchFlag:   "kill-switch",
+		FallbackProvider: true,
+		History:          true,
+		AuditLog:         true,
+		DataSourceMode:   DataSourcePoll,
+	}
+	if full != expected {
+		t.Errorf("Expected capabilities %+v, got %+v", expected, full)
+	}
+
+	// Staleness can't be detected without a fetch tracker
+	if setupTestProvider(WithFlagStaleness(time.Minute)).Describe().StaleDetection {
+		t.Error("Expected no stale detection without a fetch tracker")
+	}
+}
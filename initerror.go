@@ -0,0 +1,42 @@
+package growthbook
+
+import (
+	"context"
+	"errors"
+	"net"
+	"regexp"
+	"strconv"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// statusCodePattern extracts the HTTP status of a failed features request.
+// The GrowthBook client only reports it in the error message.
+var statusCodePattern = regexp.MustCompile(`code: (\d{3})$`)
+
+// initErrorCode classifies an error from loading features during Init.
+// Timeouts, network failures and server-side HTTP errors may go away on their
+// own, so they get GeneralCode, which the OpenFeature SDK treats as a
+// recoverable error (the Go SDK has no separate PROVIDER_ERROR code). Auth and
+// configuration errors, such as a rejected client key or a missing decryption
+// key, and anything unrecognized, are fatal.
+func initErrorCode(err error) openfeature.ErrorCode {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return openfeature.GeneralCode
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return openfeature.GeneralCode
+	}
+	if errors.Is(err, gb.ErrNoDecryptionKey) {
+		return openfeature.ProviderFatalCode
+	}
+	if match := statusCodePattern.FindStringSubmatch(err.Error()); match != nil {
+		status, _ := strconv.Atoi(match[1])
+		if status == 408 || status == 429 || status >= 500 {
+			return openfeature.GeneralCode
+		}
+	}
+	return openfeature.ProviderFatalCode
+}
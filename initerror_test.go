@@ -0,0 +1,179 @@
+package growthbook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// initWithServer initializes a provider whose client polls apiHost and
+// returns the error code of the Init failure
+func initWithServer(t *testing.T, apiHost string) openfeature.ErrorCode {
+	t.Helper()
+	dsCtx, cancelDataSource := context.WithCancel(context.Background())
+	defer cancelDataSource()
+
+	gbClient, _ := gb.NewClient(
+		dsCtx,
+		gb.WithApiHost(apiHost),
+		gb.WithClientKey("sdk-test"),
+		gb.WithPollDataSource(time.Hour),
+	)
+	provider := NewProvider(gbClient, WithLoadTimeout(100*time.Millisecond))
+
+	err := provider.Init(openfeature.NewEvaluationContext("", nil))
+	var initErr *openfeature.ProviderInitError
+	if !errors.As(err, &initErr) {
+		t.Fatalf("Expected a ProviderInitError, got %v", err)
+	}
+	return initErr.ErrorCode
+}
+
+func TestInitErrorClassification(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		expected openfeature.ErrorCode
+	}{
+		{
+			name:     "rejected client key",
+			handler:  func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusUnauthorized) },
+			expected: openfeature.ProviderFatalCode,
+		},
+		{
+			name:     "server error",
+			handler:  func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusServiceUnavailable) },
+			expected: openfeature.GeneralCode,
+		},
+		{
+			name:     "timeout",
+			handler:  func(w http.ResponseWriter, r *http.Request) { <-r.Context().Done() },
+			expected: openfeature.GeneralCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			if code := initWithServer(t, server.URL); code != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, code)
+			}
+		})
+	}
+}
+
+func TestInitErrorNetworkFailureIsRecoverable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	apiHost := server.URL
+	server.Close()
+
+	if code := initWithServer(t, apiHost); code != openfeature.GeneralCode {
+		t.Errorf("Expected %s for a refused connection, got %s", openfeature.GeneralCode, code)
+	}
+}
+
+func TestInitErrorCode(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected openfeature.ErrorCode
+	}{
+		{context.DeadlineExceeded, openfeature.GeneralCode},
+		{fmt.Errorf("Error loading features, code: %d", 429), openfeature.GeneralCode},
+		{fmt.Errorf("Error loading features, code: %d", 403), openfeature.ProviderFatalCode},
+		{gb.ErrNoDecryptionKey, openfeature.ProviderFatalCode},
+		{errors.New("something unexpected"), openfeature.ProviderFatalCode},
+	}
+	for _, tt := range tests {
+		if code := initErrorCode(tt.err); code != tt.expected {
+			t.Errorf("Expected %s for %q, got %s", tt.expected, tt.err, code)
+		}
+	}
+}
+
+func TestHTTPClientTimeoutFailsInitFast(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A CDN that accepts the connection but doesn't answer
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	// The HTTP client belongs to the GrowthBook client, which does the fetching
+	gbClient, _ := gb.NewClient(context.Background(),
+		gb.WithApiHost(server.URL),
+		gb.WithClientKey("sdk-test"),
+		gb.WithHttpClient(&http.Client{Timeout: 50 * time.Millisecond}),
+		gb.WithPollDataSource(time.Hour),
+	)
+	provider := NewProvider(gbClient, 10*time.Second)
+	defer provider.Shutdown()
+
+	start := time.Now()
+	err := provider.Init(openfeature.NewEvaluationContext("", nil))
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected Init to fail at the HTTP timeout rather than the load timeout, took %v", elapsed)
+	}
+	var initErr *openfeature.ProviderInitError
+	if !errors.As(err, &initErr) {
+		t.Fatalf("Expected a ProviderInitError, got %v", err)
+	}
+	if initErr.ErrorCode != openfeature.GeneralCode {
+		t.Errorf("Expected the timeout to be recoverable (%s), got %s", openfeature.GeneralCode, initErr.ErrorCode)
+	}
+	if !strings.Contains(initErr.Message, "failed to load GrowthBook features") || !strings.Contains(initErr.Message, "Timeout") {
+		t.Errorf("Expected a message naming the HTTP timeout, got %q", initErr.Message)
+	}
+}
+
+func TestInitWithContextCancellationReturnsPromptly(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	gbClient, _ := gb.NewClient(context.Background(),
+		gb.WithApiHost(server.URL),
+		gb.WithClientKey("sdk-test"),
+		gb.WithPollDataSource(time.Hour),
+	)
+	provider := NewProvider(gbClient, 30*time.Second)
+	defer provider.Shutdown()
+
+	// The application shuts down while the provider is still starting
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := provider.InitWithContext(ctx, openfeature.NewEvaluationContext("", nil))
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected Init to return once the context was cancelled, took %v", elapsed)
+	}
+	var initErr *openfeature.ProviderInitError
+	if !errors.As(err, &initErr) {
+		t.Fatalf("Expected a ProviderInitError, got %v", err)
+	}
+	if !strings.Contains(initErr.Message, context.Canceled.Error()) {
+		t.Errorf("Expected the message to report the cancellation, got %q", initErr.Message)
+	}
+	if provider.Status() != openfeature.ErrorState {
+		t.Errorf("Expected ErrorState, got %v", provider.Status())
+	}
+}
@@ -0,0 +1,63 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// JSONResolutionDetail is the result of ObjectEvaluationJSON
+type JSONResolutionDetail struct {
+	Value json.RawMessage
+	openfeature.ProviderResolutionDetail
+}
+
+// ObjectEvaluationJSON evaluates an object flag and returns its value as JSON,
+// for callers that pass the object through verbatim (e.g. to a frontend)
+// rather than decoding it. The value is marshaled once per evaluation.
+//
+// Like the typed evaluations, it returns defaultJSON with an error if the flag
+// can't be resolved, and a type mismatch if the flag's value isn't a JSON
+// object or array. Values are resolved like ObjectEvaluation's, with the
+// fallback provider, registered defaults and value transform, and marshaled.
+func (p *Provider) ObjectEvaluationJSON(ctx context.Context, flag string, defaultJSON json.RawMessage, evalCtx openfeature.FlattenedContext) (result JSONResolutionDetail) {
+	start := time.Now()
+	evalCtx = p.resolveAttributes(ctx, evalCtx)
+	defer func() { p.observe(flag, result.Value, evalCtx, result.ProviderResolutionDetail, start) }()
+	defer p.filterMetadata(&result.ProviderResolutionDetail)
+	defer p.addBaseMetadata(&result.ProviderResolutionDetail, flag, "object")
+	defer markDryRun(ctx, &result.ProviderResolutionDetail)
+
+	value, detail, fromFlag := resolveTypedValue(p, ctx, flag, nil, evalCtx, toJSONObject, "an object")
+	if value == nil {
+		return JSONResolutionDetail{Value: defaultJSON, ProviderResolutionDetail: detail}
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return JSONResolutionDetail{
+			Value: defaultJSON,
+			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
+				ResolutionError: openfeature.NewParseErrorResolutionError(
+					fmt.Sprintf("flag '%s' can't be encoded as JSON: %v", flag, err)),
+				Reason: openfeature.ErrorReason,
+			},
+		}
+	}
+	if fromFlag {
+		detail = markEqualsDefaultJSON(detail, value, defaultJSON)
+	}
+	return JSONResolutionDetail{Value: raw, ProviderResolutionDetail: detail}
+}
+
+// toJSONObject accepts the values ObjectEvaluationJSON serves, JSON objects
+// and arrays
+func toJSONObject(value interface{}) (interface{}, bool) {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return value, true
+	}
+	return nil, false
+}
@@ -0,0 +1,49 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestObjectEvaluationJSON(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	result := provider.ObjectEvaluationJSON(context.Background(), "object-flag", json.RawMessage(`{}`), nil)
+	if string(result.Value) != `{"key":"value"}` {
+		t.Errorf("Expected raw JSON %s, got %s", `{"key":"value"}`, result.Value)
+	}
+	if result.ResolutionDetail().ErrorCode != "" {
+		t.Errorf("Expected no error, got %s", result.ResolutionDetail().ErrorCode)
+	}
+}
+
+func TestObjectEvaluationJSONErrors(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+	defaultJSON := json.RawMessage(`{"fallback":true}`)
+
+	tests := []struct {
+		name string
+		flag string
+		code openfeature.ErrorCode
+	}{
+		{name: "flag not found", flag: "non-existent-flag", code: openfeature.FlagNotFoundCode},
+		{name: "type mismatch", flag: "string-flag", code: openfeature.TypeMismatchCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := provider.ObjectEvaluationJSON(context.Background(), tt.flag, defaultJSON, nil)
+			if string(result.Value) != string(defaultJSON) {
+				t.Errorf("Expected default JSON %s, got %s", defaultJSON, result.Value)
+			}
+			if result.ResolutionDetail().ErrorCode != tt.code {
+				t.Errorf("Expected error code %s, got %s", tt.code, result.ResolutionDetail().ErrorCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// FeatureLoader returns feature definitions from a custom source, either as
+// a features map, as given to gb.WithJsonFeatures, or as a GrowthBook API
+// response with a "features" field. See WithFeatureLoader.
+type FeatureLoader func(ctx context.Context) (json.RawMessage, error)
+
+// PreviewLoader returns the draft feature definitions token grants access to,
+// in the same formats as a FeatureLoader. See WithPreviewMode.
+type PreviewLoader func(ctx context.Context, token string) (json.RawMessage, error)
+
+// Refresh reloads the features with the loader set with WithFeatureLoader.
+// The features already loaded are kept if loading fails. A clone refreshes
+// the provider it was derived from.
+func (p *Provider) Refresh(ctx context.Context) error {
+	if p.parent != nil {
+		return p.parent.Refresh(ctx)
+	}
+	if p.featureLoader == nil {
+		return fmt.Errorf("the provider has no feature loader (see WithFeatureLoader)")
+	}
+	if err := p.loadFeatures(ctx); err != nil {
+		return err
+	}
+
+	p.stateMutex.Lock()
+	p.lastLoaded = p.now()
+	p.stateMutex.Unlock()
+	return nil
+}
+
+// loadFeatures sets the GrowthBook client's features to those returned by the
+// feature loader
+func (p *Provider) loadFeatures(ctx context.Context) error {
+	var data json.RawMessage
+	var err error
+	if !p.safely("feature loader", func() { data, err = p.featureLoader(ctx) }) {
+		return fmt.Errorf("feature loader panicked")
+	}
+	if err != nil {
+		return fmt.Errorf("feature loader failed: %w", err)
+	}
+	if err := setFeaturesJSON(p.gbClient, data); err != nil {
+		return fmt.Errorf("failed to parse loaded features: %w", err)
+	}
+	return nil
+}
+
+// initFeatureLoader loads the features during Init and returns the error
+// Init fails with, if any
+func (p *Provider) initFeatureLoader(ctx context.Context) error {
+	if err := p.loadFeatures(ctx); err != nil {
+		p.setState(openfeature.ErrorState)
+		return &openfeature.ProviderInitError{
+			ErrorCode: initErrorCode(err),
+			Message:   fmt.Sprintf("failed to load GrowthBook features: %v", err),
+		}
+	}
+	return nil
+}
+
+// setFeaturesJSON sets the features of client to data, a features map or an
+// API response with a "features" field. A features map may hold a flag named
+// "features", so data is only taken for an API response if that field is a
+// map of feature definitions rather than a definition itself.
+func setFeaturesJSON(client *gb.Client, data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if features, ok := fields["features"]; ok && isFeatureMap(features) {
+		return client.UpdateFromApiResponseJSON(string(data))
+	}
+	return client.SetJSONFeatures(string(data))
+}
+
+// isFeatureMap reports whether data is a JSON object of feature definitions.
+// An object whose only keys are the fields of a definition ("defaultValue"
+// and "rules") is a definition, not a map of flags with those names.
+func isFeatureMap(data json.RawMessage) bool {
+	var features map[string]json.RawMessage
+	if err := json.Unmarshal(data, &features); err != nil || features == nil {
+		return false
+	}
+	definition := len(features) > 0
+	for key, feature := range features {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(feature, &fields); err != nil || fields == nil {
+			return false
+		}
+		if key != "defaultValue" && key != "rules" {
+			definition = false
+		}
+	}
+	return !definition
+}
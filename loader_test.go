@@ -0,0 +1,138 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestFeatureLoaderServesFlags(t *testing.T) {
+	var version atomic.Int32
+	version.Store(1)
+	fixtures := map[int32]string{
+		1: `{"banner": {"defaultValue": "v1"}, "beta": {"defaultValue": true}}`,
+		2: `{"features": {"banner": {"defaultValue": "v2"}}}`,
+	}
+	loader := func(ctx context.Context) (json.RawMessage, error) {
+		if v := version.Load(); v > 0 {
+			return json.RawMessage(fixtures[v]), nil
+		}
+		return nil, errors.New("config service unavailable")
+	}
+
+	gbClient, _ := gb.NewClient(context.Background())
+	provider := NewProvider(gbClient, false, WithFeatureLoader(loader))
+	if err := provider.Init(openfeature.NewEvaluationContext("", nil)); err != nil {
+		t.Fatalf("Expected Init to succeed, got %v", err)
+	}
+	if result := provider.StringEvaluation(context.Background(), "banner", "", nil); result.Value != "v1" {
+		t.Errorf("Expected the loaded value, got %q (%v)", result.Value, result.Error())
+	}
+	if result := provider.BooleanEvaluation(context.Background(), "beta", false, nil); !result.Value {
+		t.Error("Expected the loaded boolean flag to resolve")
+	}
+
+	// Refresh accepts an API response too
+	version.Store(2)
+	if err := provider.Refresh(context.Background()); err != nil {
+		t.Fatalf("Expected Refresh to succeed, got %v", err)
+	}
+	if result := provider.StringEvaluation(context.Background(), "banner", "", nil); result.Value != "v2" {
+		t.Errorf("Expected the refreshed value, got %q", result.Value)
+	}
+
+	// A failed refresh keeps the loaded features
+	version.Store(0)
+	if err := provider.Refresh(context.Background()); err == nil {
+		t.Error("Expected Refresh to report the loader error")
+	}
+	if result := provider.StringEvaluation(context.Background(), "banner", "", nil); result.Value != "v2" {
+		t.Errorf("Expected the features to be kept after a failed refresh, got %q", result.Value)
+	}
+}
+
+func TestFeatureLoaderFailureFailsInit(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background())
+	provider := NewProvider(gbClient, false, WithFeatureLoader(func(ctx context.Context) (json.RawMessage, error) {
+		return json.RawMessage(`not json`), nil
+	}))
+
+	err := provider.Init(openfeature.NewEvaluationContext("", nil))
+	var initErr *openfeature.ProviderInitError
+	if !errors.As(err, &initErr) {
+		t.Fatalf("Expected a ProviderInitError, got %v", err)
+	}
+	if provider.Status() != openfeature.ErrorState {
+		t.Errorf("Expected ErrorState, got %v", provider.Status())
+	}
+}
+
+func TestRefreshWithoutFeatureLoader(t *testing.T) {
+	provider := setupTestProvider()
+	if err := provider.Refresh(context.Background()); err == nil {
+		t.Error("Expected Refresh to fail without a feature loader")
+	}
+}
+
+func TestPreviewModeServesDrafts(t *testing.T) {
+	var token string
+	loader := func(ctx context.Context, previewToken string) (json.RawMessage, error) {
+		token = previewToken
+		return json.RawMessage(`{"features": {"banner": {"defaultValue": "draft copy"}}}`), nil
+	}
+
+	// The published features would give a different value
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{"banner": {"defaultValue": "published copy"}}`))
+	provider := NewProvider(gbClient, false, WithPreviewMode("preview-token", loader))
+	if err := provider.Init(openfeature.NewEvaluationContext("", nil)); err != nil {
+		t.Fatalf("Expected Init to succeed, got %v", err)
+	}
+	if token != "preview-token" {
+		t.Errorf("Expected the loader to get the preview token, got %q", token)
+	}
+
+	result := provider.StringEvaluation(context.Background(), "banner", "", nil)
+	if result.Value != "draft copy" {
+		t.Errorf("Expected the draft value, got %q (%v)", result.Value, result.Error())
+	}
+	if result.FlagMetadata["preview"] != true {
+		t.Errorf("Expected preview metadata, got %v", result.FlagMetadata)
+	}
+}
+
+func TestSetFeaturesJSONFlagNamedFeatures(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want map[string]interface{}
+	}{
+		{"scalar default", `{"features": {"defaultValue": true}, "banner": {"defaultValue": "v1"}}`,
+			map[string]interface{}{"features": true, "banner": "v1"}},
+		{"object default with rules", `{"features": {"defaultValue": {"beta": true}, "rules": [{"force": {"beta": false}, "coverage": 0}]}}`,
+			map[string]interface{}{"features": map[string]interface{}{"beta": true}}},
+		{"api response", `{"features": {"features": {"defaultValue": 1}, "banner": {"defaultValue": "v2"}}, "dateUpdated": "2024-01-01T00:00:00Z"}`,
+			map[string]interface{}{"features": float64(1), "banner": "v2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gbClient, _ := gb.NewClient(context.Background())
+			if err := setFeaturesJSON(gbClient, []byte(tt.data)); err != nil {
+				t.Fatalf("setFeaturesJSON failed: %v", err)
+			}
+			if len(gbClient.Features()) != len(tt.want) {
+				t.Errorf("Expected %d flags, got %v", len(tt.want), gbClient.Features())
+			}
+			for key, want := range tt.want {
+				if got := gbClient.EvalFeature(context.Background(), key).Value; !reflect.DeepEqual(got, want) {
+					t.Errorf("Expected %s to be %v, got %v", key, want, got)
+				}
+			}
+		})
+	}
+}
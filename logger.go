@@ -0,0 +1,60 @@
+package growthbook
+
+import (
+	"log/slog"
+	"sync/atomic"
+)
+
+// sinks holds the logger and metrics sink, which SetLogger and SetMetrics
+// replace while evaluations run. It's shared with clones.
+type sinks struct {
+	logger  atomic.Pointer[slog.Logger]
+	metrics atomic.Pointer[metricsSink]
+}
+
+// metricsSink boxes a Metrics, since atomic.Pointer needs a concrete type
+type metricsSink struct {
+	Metrics
+}
+
+func (s *sinks) setMetrics(metrics Metrics) {
+	if metrics == nil {
+		s.metrics.Store(nil)
+		return
+	}
+	s.metrics.Store(&metricsSink{metrics})
+}
+
+// log returns the logger set with WithLogger or SetLogger, or slog's default
+// logger
+func (p *Provider) log() *slog.Logger {
+	if logger := p.sinks.logger.Load(); logger != nil {
+		return logger
+	}
+	return slog.Default()
+}
+
+// currentMetrics returns the metrics sink set with WithMetrics or SetMetrics,
+// or nil
+func (p *Provider) currentMetrics() Metrics {
+	if sink := p.sinks.metrics.Load(); sink != nil {
+		return sink.Metrics
+	}
+	return nil
+}
+
+// SetLogger replaces the logger while the provider runs, for example to raise
+// the log level during an incident. Evaluations already running may still log
+// to the previous logger. A nil logger restores slog's default logger. Clones
+// share the logger of the provider they were derived from.
+func (p *Provider) SetLogger(logger *slog.Logger) {
+	p.sinks.logger.Store(logger)
+}
+
+// SetMetrics replaces the metrics sink while the provider runs. Evaluations
+// already running may still report to the previous sink. A nil sink stops
+// reporting metrics. Clones share the sink of the provider they were derived
+// from.
+func (p *Provider) SetMetrics(metrics Metrics) {
+	p.sinks.setMetrics(metrics)
+}
@@ -0,0 +1,62 @@
+package growthbook
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestSetLoggerAndMetricsWhileEvaluating(t *testing.T) {
+	// Dropped attributes are logged at debug level on every evaluation
+	provider := setupTestProvider(WithAttributeAllowlist([]string{"country"}), WithMetrics(newCountingMetrics()))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	evalCtx := openfeature.FlattenedContext{"country": "US", "email": "user@example.com"}
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					provider.BooleanEvaluation(context.Background(), "bool-flag", false, evalCtx)
+				}
+			}
+		}()
+	}
+	for i := 0; i < 100; i++ {
+		var buf bytes.Buffer
+		provider.SetLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+		provider.SetMetrics(newCountingMetrics())
+	}
+	close(stop)
+	wg.Wait()
+
+	var buf bytes.Buffer
+	metrics := newCountingMetrics()
+	provider.SetLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	provider.SetMetrics(metrics)
+	provider.Clone(nil).BooleanEvaluation(context.Background(), "bool-flag", false, evalCtx)
+	if !strings.Contains(buf.String(), "Dropped attributes") {
+		t.Errorf("Expected the clone to log to the new logger, got %q", buf.String())
+	}
+	if metrics.evaluations["bool-flag"] != 1 {
+		t.Errorf("Expected the new metrics sink to count the evaluation, got %v", metrics.evaluations)
+	}
+
+	// A nil sink stops reporting metrics
+	provider.SetMetrics(nil)
+	provider.BooleanEvaluation(context.Background(), "bool-flag", false, evalCtx)
+	if metrics.evaluations["bool-flag"] != 1 {
+		t.Errorf("Expected no metrics after SetMetrics(nil), got %v", metrics.evaluations)
+	}
+}
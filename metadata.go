@@ -0,0 +1,90 @@
+package growthbook
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// filterMetadata passes the metadata of detail through the filter set with
+// WithMetadataFilter, if any. The filter gets a copy, so metadata shared with
+// the evaluation cache isn't changed by it.
+func (p *Provider) filterMetadata(detail *openfeature.ProviderResolutionDetail) {
+	if p.metadataFilter == nil {
+		return
+	}
+	metadata := make(openfeature.FlagMetadata, len(detail.FlagMetadata))
+	for key, value := range detail.FlagMetadata {
+		metadata[key] = value
+	}
+	var filtered openfeature.FlagMetadata
+	if !p.safely("metadata filter", func() { filtered = p.metadataFilter(metadata) }) {
+		filtered = nil
+	}
+	detail.FlagMetadata = filtered
+}
+
+// withMetadata returns detail with key set to value in a copy of its
+// metadata, so metadata shared with the evaluation cache isn't changed
+func withMetadata(detail openfeature.ProviderResolutionDetail, key string, value interface{}) openfeature.ProviderResolutionDetail {
+	metadata := make(openfeature.FlagMetadata, len(detail.FlagMetadata)+1)
+	for k, v := range detail.FlagMetadata {
+		metadata[k] = v
+	}
+	metadata[key] = value
+	detail.FlagMetadata = metadata
+	return detail
+}
+
+// markEqualsDefault adds the "equalsDefault" metadata to the detail of a value
+// resolved from a flag when it deep-equals the caller's default value, which
+// can hide a misconfigured flag
+func markEqualsDefault(detail openfeature.ProviderResolutionDetail, value, defaultValue interface{}) openfeature.ProviderResolutionDetail {
+	if !reflect.DeepEqual(value, defaultValue) {
+		return detail
+	}
+	return withMetadata(detail, "equalsDefault", true)
+}
+
+// markEqualsDefaultJSON is markEqualsDefault for JSON values, which are
+// compared decoded so formatting doesn't matter
+func markEqualsDefaultJSON(detail openfeature.ProviderResolutionDetail, value interface{}, defaultJSON json.RawMessage) openfeature.ProviderResolutionDetail {
+	var defaultValue interface{}
+	if err := json.Unmarshal(defaultJSON, &defaultValue); err != nil {
+		return detail
+	}
+	return markEqualsDefault(detail, value, defaultValue)
+}
+
+// addBaseMetadata adds the metadata every result carries, whatever its path,
+// so results can be logged uniformly: the flag key, the requested type and the
+// provider name
+func (p *Provider) addBaseMetadata(detail *openfeature.ProviderResolutionDetail, flag string, requestedType string) {
+	metadata := make(openfeature.FlagMetadata, len(detail.FlagMetadata)+3)
+	for key, value := range detail.FlagMetadata {
+		metadata[key] = value
+	}
+	metadata["flagKey"] = flag
+	metadata["requestedType"] = requestedType
+	metadata["providerName"] = p.Metadata().Name
+	detail.FlagMetadata = metadata
+}
+
+// requestedType names the OpenFeature type of an evaluation resolving to T.
+// Objects resolve to interface{}, whose zero value has no type.
+func requestedType[T any]() string {
+	var zero T
+	switch any(zero).(type) {
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		return "float"
+	case int64:
+		return "integer"
+	default:
+		return "object"
+	}
+}
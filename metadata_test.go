@@ -0,0 +1,190 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func stripSource(md openfeature.FlagMetadata) openfeature.FlagMetadata {
+	delete(md, "source")
+	return md
+}
+
+func TestMetadataFilterStripsSource(t *testing.T) {
+	provider := setupTestProvider(WithMetadataFilter(stripSource))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+	if _, ok := result.FlagMetadata["source"]; ok {
+		t.Errorf("Expected source to be stripped, got %v", result.FlagMetadata)
+	}
+	if _, ok := result.FlagMetadata["experiment"]; !ok {
+		t.Errorf("Expected other metadata to be kept, got %v", result.FlagMetadata)
+	}
+
+	json := provider.ObjectEvaluationJSON(context.Background(), "object-flag", nil, nil)
+	if _, ok := json.FlagMetadata["source"]; ok {
+		t.Errorf("Expected source to be stripped from JSON results, got %v", json.FlagMetadata)
+	}
+}
+
+func TestMetadataFilterAppliesToCachedResults(t *testing.T) {
+	provider := setupTestProvider(WithMetadataFilter(stripSource), WithEvaluationCache(time.Minute))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	evalCtx := openfeature.FlattenedContext{"targetingKey": "user-1"}
+	_ = provider.StringEvaluation(context.Background(), "string-flag", "", evalCtx)
+	cached := provider.StringEvaluation(context.Background(), "string-flag", "", evalCtx)
+	if cached.Reason != openfeature.CachedReason {
+		t.Fatalf("Expected a cache hit, got %s", cached.Reason)
+	}
+	if _, ok := cached.FlagMetadata["source"]; ok {
+		t.Errorf("Expected source to be stripped from cached results, got %v", cached.FlagMetadata)
+	}
+	if cached.FlagMetadata["originalReason"] != string(openfeature.DefaultReason) {
+		t.Errorf("Expected the original reason to be kept, got %v", cached.FlagMetadata)
+	}
+}
+
+func TestMetadataFilterPanicDropsMetadata(t *testing.T) {
+	provider := setupTestProvider(WithMetadataFilter(func(openfeature.FlagMetadata) openfeature.FlagMetadata {
+		panic("filter bug")
+	}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.IntEvaluation(context.Background(), "int-flag", 0, nil)
+	if result.Value != 42 {
+		t.Errorf("Expected the flag value, got %d", result.Value)
+	}
+	if len(result.FlagMetadata) != 0 {
+		t.Errorf("Expected no metadata after the filter panicked, got %v", result.FlagMetadata)
+	}
+}
+
+func TestEqualsDefaultMarker(t *testing.T) {
+	provider := setupTestProvider(WithEvaluationCache(time.Minute))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	ctx := context.Background()
+
+	equal := map[string]openfeature.FlagMetadata{
+		"bool":   provider.BooleanEvaluation(ctx, "bool-flag", true, nil).FlagMetadata,
+		"string": provider.StringEvaluation(ctx, "string-flag", "default-string", nil).FlagMetadata,
+		"float":  provider.FloatEvaluation(ctx, "number-flag", 42.5, nil).FlagMetadata,
+		"int":    provider.IntEvaluation(ctx, "int-flag", 42, nil).FlagMetadata,
+		"object": provider.ObjectEvaluation(ctx, "object-flag", map[string]interface{}{"key": "value"}, nil).FlagMetadata,
+		"json":   provider.ObjectEvaluationJSON(ctx, "object-flag", []byte(`{ "key": "value" }`), nil).FlagMetadata,
+		// Cached results are compared with the default of each call
+		"cached": provider.BooleanEvaluation(ctx, "bool-flag", true, nil).FlagMetadata,
+	}
+	for method, metadata := range equal {
+		if metadata["equalsDefault"] != true {
+			t.Errorf("Expected equalsDefault for %s, got %v", method, metadata)
+		}
+	}
+
+	different := map[string]openfeature.FlagMetadata{
+		"bool":    provider.BooleanEvaluation(ctx, "bool-flag", false, nil).FlagMetadata,
+		"string":  provider.StringEvaluation(ctx, "string-flag", "fallback", nil).FlagMetadata,
+		"object":  provider.ObjectEvaluation(ctx, "object-flag", map[string]interface{}{"key": "other"}, nil).FlagMetadata,
+		"json":    provider.ObjectEvaluationJSON(ctx, "object-flag", []byte(`{}`), nil).FlagMetadata,
+		"missing": provider.StringEvaluation(ctx, "missing-flag", "fallback", nil).FlagMetadata,
+	}
+	for method, metadata := range different {
+		if _, ok := metadata["equalsDefault"]; ok {
+			t.Errorf("Expected no equalsDefault for %s, got %v", method, metadata)
+		}
+	}
+}
+
+func TestBaseMetadataOnEveryResultPath(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		flag     string
+		typ      string
+		evaluate func(flag string) openfeature.ProviderResolutionDetail
+	}{
+		{"bool", "bool-flag", "boolean", func(flag string) openfeature.ProviderResolutionDetail {
+			return provider.BooleanEvaluation(ctx, flag, false, nil).ProviderResolutionDetail
+		}},
+		{"string", "string-flag", "string", func(flag string) openfeature.ProviderResolutionDetail {
+			return provider.StringEvaluation(ctx, flag, "", nil).ProviderResolutionDetail
+		}},
+		{"float", "number-flag", "float", func(flag string) openfeature.ProviderResolutionDetail {
+			return provider.FloatEvaluation(ctx, flag, 0, nil).ProviderResolutionDetail
+		}},
+		{"int", "int-flag", "integer", func(flag string) openfeature.ProviderResolutionDetail {
+			return provider.IntEvaluation(ctx, flag, 0, nil).ProviderResolutionDetail
+		}},
+		{"object", "object-flag", "object", func(flag string) openfeature.ProviderResolutionDetail {
+			return provider.ObjectEvaluation(ctx, flag, true, nil).ProviderResolutionDetail
+		}},
+		{"json", "object-flag", "object", func(flag string) openfeature.ProviderResolutionDetail {
+			return provider.ObjectEvaluationJSON(ctx, flag, nil, nil).ProviderResolutionDetail
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The resolved flag, a missing flag, and a flag of another type,
+			// except for ObjectEvaluation, which accepts any value
+			paths := map[string]string{"resolved": tt.flag, "not found": "missing-flag"}
+			switch tt.name {
+			case "string":
+				paths["type mismatch"] = "object-flag"
+			case "object":
+			default:
+				paths["type mismatch"] = "string-flag"
+			}
+			for path, flag := range paths {
+				detail := tt.evaluate(flag)
+				if path != "resolved" && detail.Error() == nil {
+					t.Fatalf("Expected an error for the %s path of %s", path, flag)
+				}
+				if detail.FlagMetadata["flagKey"] != flag {
+					t.Errorf("Expected flagKey %q on the %s path, got %v", flag, path, detail.FlagMetadata)
+				}
+				if detail.FlagMetadata["requestedType"] != tt.typ {
+					t.Errorf("Expected requestedType %q on the %s path, got %v", tt.typ, path, detail.FlagMetadata)
+				}
+				if detail.FlagMetadata["providerName"] != "GrowthBook Provider" {
+					t.Errorf("Expected providerName on the %s path, got %v", path, detail.FlagMetadata)
+				}
+			}
+		})
+	}
+}
+
+func TestOffMetadata(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"on-flag": {"defaultValue": true},
+		"off-flag": {"defaultValue": false},
+		"empty-banner": {"defaultValue": ""},
+		"banner": {"defaultValue": "hello"}
+	}`))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	ctx := context.Background()
+
+	tests := []struct {
+		flag string
+		off  bool
+	}{
+		{"on-flag", false},
+		{"off-flag", true},
+		{"empty-banner", true},
+		{"banner", false},
+	}
+	for _, tt := range tests {
+		result := provider.ObjectEvaluation(ctx, tt.flag, nil, nil)
+		if result.FlagMetadata["off"] != tt.off {
+			t.Errorf("Expected off=%v for %s, got %v", tt.off, tt.flag, result.FlagMetadata)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package growthbook
+
+import (
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// Metrics receives counts of evaluation outcomes, for example to export them
+// to a monitoring system. Implementations must be safe for concurrent use.
+type Metrics interface {
+	// CountEvaluation is called once for every evaluation with its reason.
+	CountEvaluation(flag string, reason openfeature.Reason)
+
+	// CountDefaultServed is called when an evaluation resolved with the
+	// DEFAULT or ERROR reason. A rising rate of these usually means flags
+	// are missing or the client is degraded. code is empty for DEFAULT.
+	CountDefaultServed(flag string, reason openfeature.Reason, code openfeature.ErrorCode)
+}
+
+// LatencyMetrics is implemented by Metrics that also record how long
+// evaluations take.
+type LatencyMetrics interface {
+	// ObserveLatency is called once for every evaluation with its reason and
+	// the time it took, including the evaluation cache and fallbacks.
+	ObserveLatency(flag string, reason openfeature.Reason, latency time.Duration)
+}
+
+// observe reports the outcome of an evaluation started at start once it's
+// complete
+func (p *Provider) observe(flag string, value interface{}, evalCtx openfeature.FlattenedContext, detail openfeature.ProviderResolutionDetail, start time.Time) {
+	p.counts.increment(flag)
+	p.warnEvaluationError(flag, detail)
+	p.recordEvaluation(flag, value, evalCtx, detail)
+	p.audit(flag, value, evalCtx, detail)
+	metrics := p.currentMetrics()
+	if metrics == nil {
+		return
+	}
+	p.safely("metrics", func() {
+		metrics.CountEvaluation(flag, detail.Reason)
+		if detail.Reason == openfeature.DefaultReason || detail.Reason == openfeature.ErrorReason {
+			metrics.CountDefaultServed(flag, detail.Reason, detail.ResolutionDetail().ErrorCode)
+		}
+		if latency, ok := metrics.(LatencyMetrics); ok {
+			latency.ObserveLatency(flag, detail.Reason, time.Since(start))
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package growthbook
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// countingMetrics records the counters reported by the provider
+type countingMetrics struct {
+	mu            sync.Mutex
+	evaluations   map[string]int
+	defaultServed map[string]int
+	codes         []openfeature.ErrorCode
+}
+
+func newCountingMetrics() *countingMetrics {
+	return &countingMetrics{evaluations: map[string]int{}, defaultServed: map[string]int{}}
+}
+
+func (m *countingMetrics) CountEvaluation(flag string, reason openfeature.Reason) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.evaluations[flag]++
+}
+
+func (m *countingMetrics) CountDefaultServed(flag string, reason openfeature.Reason, code openfeature.ErrorCode) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.defaultServed[flag]++
+	m.codes = append(m.codes, code)
+}
+
+func TestMetricsCountDefaultServedOnMissingFlag(t *testing.T) {
+	metrics := newCountingMetrics()
+	provider := setupTestProvider(WithMetrics(metrics))
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	provider.BooleanEvaluation(context.Background(), "non-existent-flag", false, nil)
+
+	if metrics.defaultServed["non-existent-flag"] != 1 {
+		t.Errorf("Expected default-served counter to be 1, got %d", metrics.defaultServed["non-existent-flag"])
+	}
+	if len(metrics.codes) != 1 || metrics.codes[0] != openfeature.FlagNotFoundCode {
+		t.Errorf("Expected FLAG_NOT_FOUND error code, got %v", metrics.codes)
+	}
+	if metrics.evaluations["non-existent-flag"] != 1 {
+		t.Errorf("Expected evaluation counter to be 1, got %d", metrics.evaluations["non-existent-flag"])
+	}
+}
+
+func TestMetricsTargetingMatchNotCountedAsDefault(t *testing.T) {
+	metrics := newCountingMetrics()
+	provider := setupTestProvider(WithMetrics(metrics))
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	provider.BooleanEvaluation(context.Background(), "rules-test", false, openfeature.FlattenedContext{"email": "user@growthbook.com"})
+	provider.StringEvaluation(context.Background(), "string-flag", "default", nil)
+
+	if metrics.defaultServed["rules-test"] != 0 {
+		t.Errorf("Expected matched rule not to count as default-served, got %d", metrics.defaultServed["rules-test"])
+	}
+	if metrics.evaluations["rules-test"] != 1 || metrics.evaluations["string-flag"] != 1 {
+		t.Errorf("Expected every evaluation to be counted, got %v", metrics.evaluations)
+	}
+}
+
+// latencyMetrics records the latencies reported by the provider
+type latencyMetrics struct {
+	*countingMetrics
+	latencies map[string][]time.Duration
+}
+
+func (m *latencyMetrics) ObserveLatency(flag string, reason openfeature.Reason, latency time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.latencies[flag] = append(m.latencies[flag], latency)
+}
+
+func TestLatencyMetricsObserveEvaluations(t *testing.T) {
+	metrics := &latencyMetrics{countingMetrics: newCountingMetrics(), latencies: map[string][]time.Duration{}}
+	provider := setupTestProvider(WithMetrics(metrics))
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+	provider.ObjectEvaluationJSON(context.Background(), "object-flag", nil, nil)
+
+	for _, flag := range []string{"bool-flag", "object-flag"} {
+		if len(metrics.latencies[flag]) != 1 || metrics.latencies[flag][0] <= 0 {
+			t.Errorf("Expected one positive latency for %s, got %v", flag, metrics.latencies[flag])
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package growthbook
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// objectSize returns the size in bytes of value encoded as JSON if it's an
+// object or array, or 0 for any other value
+func objectSize(value interface{}) int {
+	switch v := value.(type) {
+	case json.RawMessage:
+		return len(v)
+	case map[string]interface{}, []interface{}:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return 0
+		}
+		return len(encoded)
+	}
+	return 0
+}
+
+// oversized reports whether value is an object or array larger than the
+// limit set with WithMaxObjectSize
+func (p *Provider) oversized(value interface{}) bool {
+	return p.maxObjectSize > 0 && objectSize(value) > p.maxObjectSize
+}
+
+// oversizedDetail describes an object flag rejected under
+// WithRejectOversizedObjects
+func (p *Provider) oversizedDetail(flag string) openfeature.ProviderResolutionDetail {
+	return openfeature.ProviderResolutionDetail{
+		ResolutionError: openfeature.NewParseErrorResolutionError(
+			fmt.Sprintf("flag '%s' is larger than the maximum object size of %d bytes", flag, p.maxObjectSize)),
+		Reason: openfeature.ErrorReason,
+	}
+}
@@ -0,0 +1,81 @@
+package growthbook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func setupObjectSizeProvider(options ...interface{}) (*Provider, *bytes.Buffer) {
+	featuresJSON := `{
+		"small": {"defaultValue": {"theme": "dark"}},
+		"large": {"defaultValue": {"blob": "` + strings.Repeat("x", 1024) + `"}}
+	}`
+	var buf bytes.Buffer
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	options = append([]interface{}{false, WithEvaluationCache(time.Minute), WithAuditLog(&buf), WithMaxObjectSize(256)}, options...)
+	provider := NewProvider(gbClient, options...)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	return provider, &buf
+}
+
+func TestOversizedObjectsSkipCacheAndAudit(t *testing.T) {
+	provider, buf := setupObjectSizeProvider()
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		result := provider.ObjectEvaluation(ctx, "large", nil, nil)
+		if result.Value == nil || result.ResolutionDetail().ErrorCode != "" {
+			t.Fatalf("Expected the oversized object to be returned, got %v (%s)", result.Value, result.ResolutionDetail().ErrorCode)
+		}
+		if result.Reason == openfeature.CachedReason {
+			t.Error("Expected the oversized object not to be cached")
+		}
+	}
+	provider.ObjectEvaluationJSON(ctx, "large", nil, nil)
+
+	provider.ObjectEvaluation(ctx, "small", nil, nil)
+	if cached := provider.ObjectEvaluation(ctx, "small", nil, nil); cached.Reason != openfeature.CachedReason {
+		t.Errorf("Expected an object within the limit to be cached, got %s", cached.Reason)
+	}
+
+	lines := auditLines(t, buf)
+	if len(lines) != 2 {
+		t.Fatalf("Expected only the small object's evaluations to be audited, got %d lines", len(lines))
+	}
+	for _, line := range lines {
+		if line["flag"] != "small" {
+			t.Errorf("Expected no audit line for the oversized object, got %v", line)
+		}
+	}
+}
+
+func TestRejectOversizedObjects(t *testing.T) {
+	provider, buf := setupObjectSizeProvider(WithRejectOversizedObjects(true))
+	ctx := context.Background()
+	fallback := map[string]interface{}{"blob": "default"}
+
+	result := provider.ObjectEvaluation(ctx, "large", fallback, nil)
+	if result.ResolutionDetail().ErrorCode != openfeature.ParseErrorCode {
+		t.Errorf("Expected a PARSE_ERROR, got %q", result.ResolutionDetail().ErrorCode)
+	}
+	if value, _ := result.Value.(map[string]interface{}); value["blob"] != "default" {
+		t.Errorf("Expected the default value, got %v", result.Value)
+	}
+
+	jsonResult := provider.ObjectEvaluationJSON(ctx, "large", json.RawMessage(`{}`), nil)
+	if jsonResult.ResolutionDetail().ErrorCode != openfeature.ParseErrorCode || string(jsonResult.Value) != `{}` {
+		t.Errorf("Expected the default JSON with a PARSE_ERROR, got %s (%q)", jsonResult.Value, jsonResult.ResolutionDetail().ErrorCode)
+	}
+
+	// The rejections themselves are small enough to be audited
+	if lines := auditLines(t, buf); len(lines) != 2 || lines[0]["errorCode"] != string(openfeature.ParseErrorCode) {
+		t.Errorf("Expected the rejections to be audited, got %v", lines)
+	}
+}
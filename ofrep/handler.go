@@ -0,0 +1,147 @@
+// Package ofrep serves flag evaluations of an OpenFeature provider over the
+// OpenFeature Remote Evaluation Protocol (OFREP).
+package ofrep
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// EvaluatePath is the route of single flag evaluations in OFREP
+const EvaluatePath = "/ofrep/v1/evaluate/flags/{key}"
+
+// evaluationRequest is the body of an OFREP evaluation request
+type evaluationRequest struct {
+	Context map[string]interface{} `json:"context"`
+}
+
+// evaluationSuccess is the body of a successful OFREP evaluation
+type evaluationSuccess struct {
+	Key      string                 `json:"key"`
+	Value    interface{}            `json:"value"`
+	Reason   string                 `json:"reason,omitempty"`
+	Variant  string                 `json:"variant,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// evaluationFailure is the body of a failed OFREP evaluation
+type evaluationFailure struct {
+	Key          string `json:"key"`
+	ErrorCode    string `json:"errorCode"`
+	ErrorDetails string `json:"errorDetails,omitempty"`
+}
+
+// Handler answers OFREP single flag evaluation requests
+// (POST /ofrep/v1/evaluate/flags/{key}) using an OpenFeature provider.
+//
+// OFREP requests don't say which type the flag should have, so flags are
+// evaluated as objects and their value is returned as is. As an extension, a
+// "type" query parameter of "boolean", "string", "integer", "float" or
+// "object" evaluates the flag as that type, answering TYPE_MISMATCH if the
+// flag has another type.
+type Handler struct {
+	provider openfeature.FeatureProvider
+	mux      *http.ServeMux
+}
+
+// NewHandler creates a Handler evaluating flags with provider
+func NewHandler(provider openfeature.FeatureProvider) *Handler {
+	h := &Handler{provider: provider, mux: http.NewServeMux()}
+	h.mux.HandleFunc("POST "+EvaluatePath, h.evaluate)
+	return h
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// evaluate handles a single flag evaluation request
+func (h *Handler) evaluate(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+
+	var request evaluationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeJSON(w, http.StatusBadRequest, evaluationFailure{
+			Key:          key,
+			ErrorCode:    string(openfeature.InvalidContextCode),
+			ErrorDetails: fmt.Sprintf("invalid evaluation request: %v", err),
+		})
+		return
+	}
+
+	value, detail, ok := h.resolve(r, key, openfeature.FlattenedContext(request.Context))
+	if !ok {
+		writeJSON(w, http.StatusBadRequest, evaluationFailure{
+			Key:          key,
+			ErrorCode:    string(openfeature.GeneralCode),
+			ErrorDetails: fmt.Sprintf("unsupported flag type %q", r.URL.Query().Get("type")),
+		})
+		return
+	}
+
+	resolution := detail.ResolutionDetail()
+	if resolution.ErrorCode != "" {
+		writeJSON(w, statusFor(resolution.ErrorCode), evaluationFailure{
+			Key:          key,
+			ErrorCode:    string(resolution.ErrorCode),
+			ErrorDetails: resolution.ErrorMessage,
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, evaluationSuccess{
+		Key:      key,
+		Value:    value,
+		Reason:   string(detail.Reason),
+		Variant:  detail.Variant,
+		Metadata: detail.FlagMetadata,
+	})
+}
+
+// resolve evaluates the flag as the type requested by the "type" query
+// parameter. ok is false if the type isn't supported.
+func (h *Handler) resolve(r *http.Request, key string, evalCtx openfeature.FlattenedContext) (value interface{}, detail openfeature.ProviderResolutionDetail, ok bool) {
+	ctx := r.Context()
+	switch r.URL.Query().Get("type") {
+	case "", "object":
+		result := h.provider.ObjectEvaluation(ctx, key, nil, evalCtx)
+		return result.Value, result.ProviderResolutionDetail, true
+	case "boolean":
+		result := h.provider.BooleanEvaluation(ctx, key, false, evalCtx)
+		return result.Value, result.ProviderResolutionDetail, true
+	case "string":
+		result := h.provider.StringEvaluation(ctx, key, "", evalCtx)
+		return result.Value, result.ProviderResolutionDetail, true
+	case "integer":
+		result := h.provider.IntEvaluation(ctx, key, 0, evalCtx)
+		return result.Value, result.ProviderResolutionDetail, true
+	case "float":
+		result := h.provider.FloatEvaluation(ctx, key, 0, evalCtx)
+		return result.Value, result.ProviderResolutionDetail, true
+	}
+	return nil, detail, false
+}
+
+// statusFor returns the HTTP status OFREP uses for an evaluation error
+func statusFor(code openfeature.ErrorCode) int {
+	switch code {
+	case openfeature.FlagNotFoundCode:
+		return http.StatusNotFound
+	case openfeature.ParseErrorCode, openfeature.TypeMismatchCode,
+		openfeature.TargetingKeyMissingCode, openfeature.InvalidContextCode:
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// writeJSON writes body as a JSON response with the given status
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	//nolint:errcheck
+	json.NewEncoder(w).Encode(body)
+}
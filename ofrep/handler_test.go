@@ -0,0 +1,101 @@
+package ofrep
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	gbprovider "github.com/growthbook/growthbook-openfeature-provider-go"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func setupOFREPServer(t *testing.T) *httptest.Server {
+	featuresJSON := `{
+		"new-checkout": {
+			"defaultValue": false,
+			"rules": [{"condition": {"country": "NZ"}, "force": true}]
+		},
+		"banner-text": {"defaultValue": "hello"}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := gbprovider.NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	server := httptest.NewServer(NewHandler(provider))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func postEvaluation(t *testing.T, url string, body string) (int, map[string]interface{}) {
+	t.Helper()
+	resp, err := http.Post(url, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var decoded map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	return resp.StatusCode, decoded
+}
+
+func TestHandlerEvaluatesFlag(t *testing.T) {
+	server := setupOFREPServer(t)
+
+	status, body := postEvaluation(t, server.URL+"/ofrep/v1/evaluate/flags/new-checkout",
+		`{"context": {"targetingKey": "user-1", "country": "NZ"}}`)
+	if status != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %v", status, body)
+	}
+	if body["key"] != "new-checkout" || body["value"] != true {
+		t.Errorf("Expected new-checkout to be true, got %v", body)
+	}
+	if body["reason"] != string(openfeature.TargetingMatchReason) {
+		t.Errorf("Expected reason %s, got %v", openfeature.TargetingMatchReason, body["reason"])
+	}
+	if _, ok := body["metadata"].(map[string]interface{}); !ok {
+		t.Errorf("Expected metadata object, got %v", body["metadata"])
+	}
+}
+
+func TestHandlerFlagNotFound(t *testing.T) {
+	server := setupOFREPServer(t)
+
+	status, body := postEvaluation(t, server.URL+"/ofrep/v1/evaluate/flags/missing", `{"context": {}}`)
+	if status != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", status)
+	}
+	if body["errorCode"] != string(openfeature.FlagNotFoundCode) {
+		t.Errorf("Expected errorCode %s, got %v", openfeature.FlagNotFoundCode, body["errorCode"])
+	}
+}
+
+func TestHandlerTypeMismatch(t *testing.T) {
+	server := setupOFREPServer(t)
+
+	status, body := postEvaluation(t, server.URL+"/ofrep/v1/evaluate/flags/banner-text?type=boolean", `{"context": {}}`)
+	if status != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", status)
+	}
+	if body["errorCode"] != string(openfeature.TypeMismatchCode) {
+		t.Errorf("Expected errorCode %s, got %v", openfeature.TypeMismatchCode, body["errorCode"])
+	}
+}
+
+func TestHandlerInvalidContext(t *testing.T) {
+	server := setupOFREPServer(t)
+
+	status, body := postEvaluation(t, server.URL+"/ofrep/v1/evaluate/flags/banner-text", `{"context": "not an object"}`)
+	if status != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", status)
+	}
+	if body["errorCode"] != string(openfeature.InvalidContextCode) {
+		t.Errorf("Expected errorCode %s, got %v", openfeature.InvalidContextCode, body["errorCode"])
+	}
+}
@@ -0,0 +1,719 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// config holds the provider settings set through NewProvider and its options
+type config struct {
+	loadTimeout      time.Duration // Timeout for feature loading during Init
+	evalTimeout      time.Duration // Deadline applied to each evaluation (0 means none)
+	usesDataSource   bool          // Whether the client uses a built-in data source
+	dataSourceMode   DataSourceMode
+	contextMerge     ContextMergeFunc
+	nestedContexts   []string      // Evaluation context keys holding contexts merged into the attributes
+	evalRetryWait    time.Duration // Wait before retrying an unknown flag (0 disables retries)
+	staleTTL         time.Duration // Age after which loaded features are considered stale (0 disables)
+	staleState       bool          // Whether to move to StaleState when features are stale
+	fetchTracker     *FetchTracker // Records successful fetches of the client's data source
+	now              func() time.Time
+	trackingCallback TrackingCallback
+	staticHints      map[string]interface{} // Attributes merged beneath every evaluation context
+	fallbackAttrs    map[string]string      // Flag key to fallback hash attribute
+	numericStrings   bool                   // Whether numeric strings are accepted by Int/Float evaluation
+	sinks            *sinks                 // Logger and metrics, replaceable at runtime and shared with clones
+	forcedVariations map[string]int         // Experiment key to forced variation index
+	changeInterval   time.Duration          // How often features are checked for changes (0 disables change events)
+	history          *evaluationHistory     // Recent evaluations, shared with clones
+	killSwitchFlag   string                 // Flag whose false value disables all other flags
+	knownFlags       map[string]bool        // Flags reported as DISABLED rather than not found when missing
+	devMode          bool
+	devOverrides     map[string]interface{} // Flag values forced in dev mode
+	requiredAttrs    []string               // Attributes every evaluation must have
+	remoteEval       bool                   // Whether flags are evaluated by GrowthBook's remote evaluation endpoint
+	remoteEndpoint   *remoteEvalEndpoint
+	transforms       map[string]func(value interface{}) interface{}          // Flag key to resolved value transform
+	fallbackProvider openfeature.FeatureProvider                             // Consulted for flags GrowthBook can't resolve
+	initAttributes   bool                                                    // Whether the Init context's attributes apply to evaluations
+	groupMembership  map[string]bool                                         // Saved group ID to forced membership, for tests
+	auditLog         *auditLog                                               // Audit log of every evaluation, shared with clones
+	omitZeroAttrs    bool                                                    // Whether zero-valued attributes are dropped before evaluation
+	cache            *evaluationCache                                        // Cached evaluation results, shared with clones
+	domain           string                                                  // OpenFeature domain the provider is bound to, for observability
+	providerName     string                                                  // Metadata name, "GrowthBook Provider" when empty
+	healthInterval   time.Duration                                           // How often the feature count is logged (0 disables health logs)
+	metadataFilter   func(openfeature.FlagMetadata) openfeature.FlagMetadata // Applied to the metadata of every result
+	maxObjectSize    int                                                     // Objects larger than this many bytes of JSON aren't cached or audited
+	rejectOversized  bool                                                    // Whether objects over maxObjectSize fail with PARSE_ERROR
+	emptyAsDefault   bool                                                    // Whether empty objects and arrays resolve to the default value
+	overrides        *overrideStore                                          // Flag values forced with SetOverride, shared with clones
+	defaults         *defaultsRegistry                                       // Typed defaults set with RegisterDefault, shared with clones
+	counts           *evaluationCounts                                       // Evaluations per flag, shared with clones
+	warnLimiter      *warningLimiter                                         // Rate limit of evaluation error warnings, shared with clones
+	timeAttr         string                                                  // Attribute set to the evaluation time, for time-based conditions
+	normalizers      map[string]func(string) string                          // Attribute key to normalizer of its string values
+	boolAttrs        map[string]bool                                         // Attributes whose "true"/"false"/"1"/"0" strings become booleans
+	attrAllowlist    map[string]bool                                         // Evaluation context attributes passed to GrowthBook (nil allows all)
+	hashShortCircuit bool                                                    // Whether experiment-only flags are skipped without a hash attribute
+	attrResolver     func(ctx context.Context, attrs map[string]interface{}) map[string]interface{}
+	attrSources      []AttributeSource
+	objectDecoder    func(data []byte, v interface{}) error
+	featureLoader    FeatureLoader
+	splitReason      bool
+	preview          bool
+	reservedKeys     func(key string) string
+	forcedResults    map[string]*gb.FeatureResult
+}
+
+// Option configures optional behavior of the Provider. Options are passed to
+// NewProvider alongside the positional timeout and usesDataSource arguments.
+type Option func(*Provider)
+
+// WithDataSourceMode records which kind of data source the GrowthBook client
+// uses. The client doesn't expose this itself, so it's only used for reporting
+// through Info, and a mode observed by a fetch tracker takes precedence.
+func WithDataSourceMode(mode DataSourceMode) Option {
+	return func(p *Provider) {
+		p.dataSourceMode = mode
+	}
+}
+
+// WithContextMerge sets the strategy used to combine multiple evaluation
+// contexts, both by MergeContexts and for the nested contexts configured with
+// WithNestedContexts. The default is ShallowMerge (last context wins).
+func WithContextMerge(merge ContextMergeFunc) Option {
+	return func(p *Provider) {
+		p.contextMerge = merge
+	}
+}
+
+// WithNestedContexts names evaluation context keys that hold additional
+// contexts, such as "device" or "organization". When evaluating, the map found
+// under each key is merged into the top-level attributes with the context
+// merge strategy, in the order the keys are given, and the key itself is
+// removed. Values that aren't maps are kept as ordinary attributes.
+func WithNestedContexts(keys ...string) Option {
+	return func(p *Provider) {
+		p.nestedContexts = keys
+	}
+}
+
+// WithEvalRetry makes the provider retry an evaluation once, after waiting for
+// the given duration, when GrowthBook reports the flag as unknown. This covers
+// flags that are briefly missing while a data source reload is in flight. If
+// the flag is still unknown after the retry, a General error is returned
+// instead of FlagNotFound. Retries only happen when a data source is used.
+func WithEvalRetry(wait time.Duration) Option {
+	return func(p *Provider) {
+		p.evalRetryWait = wait
+	}
+}
+
+// WithFlagStaleness marks evaluation results with "stale": true in their flag
+// metadata once the last successful fetch of features is older than ttl. A
+// quiet SSE connection or a stalled poller would otherwise go unnoticed.
+// Fetches are observed through a FetchTracker (see WithFetchTracker); without
+// one, staleness can't be detected and results are never marked.
+func WithFlagStaleness(ttl time.Duration) Option {
+	return func(p *Provider) {
+		p.staleTTL = ttl
+	}
+}
+
+// WithStaleState makes the provider move to openfeature.StaleState while its
+// features are stale (see WithFlagStaleness) and back to ReadyState once they
+// are fetched again. Each transition emits a ProviderStale or ProviderReady
+// event so openfeature.Client users see it. The state is checked on every
+// evaluation and after every fetch. Evaluations keep serving stale values.
+func WithStaleState(enabled bool) Option {
+	return func(p *Provider) {
+		p.staleState = enabled
+	}
+}
+
+// WithFetchTracker sets the FetchTracker that observes the GrowthBook client's
+// HTTP traffic. It's required for staleness detection and lets Info report the
+// data source mode and the time of the last successful fetch.
+func WithFetchTracker(tracker *FetchTracker) Option {
+	return func(p *Provider) {
+		p.fetchTracker = tracker
+	}
+}
+
+// WithTrackingCallback sets a callback that is invoked whenever a user is
+// exposed to an experiment, either through a feature flag evaluation or
+// RunExperiment. Use it to forward exposures to your analytics system. The
+// callback replaces any experiment callback configured on the GrowthBook client.
+func WithTrackingCallback(callback TrackingCallback) Option {
+	return func(p *Provider) {
+		p.trackingCallback = callback
+	}
+}
+
+// WithStaticHints sets process-level attributes (hostname, pid, region, ...)
+// that are merged into every evaluation at the lowest precedence, so any
+// attribute in the evaluation context overrides them.
+func WithStaticHints(hints map[string]interface{}) Option {
+	return func(p *Provider) {
+		p.staticHints = hints
+	}
+}
+
+// WithFallbackAttributes sets, per flag key, a fallback attribute used for
+// experiment bucketing when the rule's hash attribute (usually "id") is absent
+// from the evaluation context. For example {"checkout": "deviceId"} buckets
+// anonymous users by device. The GrowthBook Go SDK ignores fallbackAttribute on
+// feature rules, so it has to be configured here.
+func WithFallbackAttributes(fallbacks map[string]string) Option {
+	return func(p *Provider) {
+		p.fallbackAttrs = fallbacks
+	}
+}
+
+// WithNumericStringParsing makes IntEvaluation and FloatEvaluation accept string
+// values that parse as numbers (e.g. "10" or "3.14"). Strings that don't parse
+// still return a TypeMismatch error. Disabled by default.
+func WithNumericStringParsing(enabled bool) Option {
+	return func(p *Provider) {
+		p.numericStrings = enabled
+	}
+}
+
+// WithLoadTimeout sets how long Init waits for the data source to load
+// features. The default is 30 seconds. It's equivalent to passing the timeout
+// as a positional time.Duration to NewProvider.
+//
+// The GrowthBook client does the fetching, so dial, response header and
+// overall HTTP timeouts and proxy settings are configured on the client with
+// gb.WithHttpClient. Init fails as soon as the client's first fetch fails, so
+// an HTTP timeout shorter than the load timeout makes a slow CDN fail Init
+// early, with the HTTP error in the message.
+func WithLoadTimeout(timeout time.Duration) Option {
+	return func(p *Provider) {
+		if timeout > 0 {
+			p.loadTimeout = timeout
+		}
+	}
+}
+
+// WithEvaluationTimeout sets a deadline applied to the context of every flag
+// evaluation, bounding evaluation retries and the context seen by tracking
+// callbacks. It's independent of the load timeout. By default evaluations
+// only honor the deadline of the caller's context.
+func WithEvaluationTimeout(timeout time.Duration) Option {
+	return func(p *Provider) {
+		p.evalTimeout = timeout
+	}
+}
+
+// WithMetrics reports evaluation outcomes, including evaluations that served
+// the default value, to the given metrics sink. See SetMetrics to replace it
+// at runtime.
+func WithMetrics(metrics Metrics) Option {
+	return func(p *Provider) {
+		p.sinks.setMetrics(metrics)
+	}
+}
+
+// WithForcedVariations forces experiments, by experiment key, to always assign
+// the variation at the given index, which is useful for QA. Use ForceVariation
+// to force a variation for a single evaluation instead.
+func WithForcedVariations(variations map[string]int) Option {
+	return func(p *Provider) {
+		p.forcedVariations = variations
+	}
+}
+
+// WithForcedResults is a test seam that makes evaluations of the given flags
+// return the given GrowthBook results instead of evaluating them, so tests of
+// downstream code control the source, rule and experiment a result maps to,
+// and not only its value as with SetOverride. The results take precedence
+// over overrides, and the flags don't need to exist in the client's features.
+func WithForcedResults(results map[string]*gb.FeatureResult) Option {
+	return func(p *Provider) {
+		p.forcedResults = results
+	}
+}
+
+// WithChangeEvents makes the provider emit PROVIDER_CONFIGURATION_CHANGED
+// events, listing the changed flags, when the client's features change. The
+// features are checked at the given interval by a goroutine that runs from
+// Init until Shutdown.
+func WithChangeEvents(interval time.Duration) Option {
+	return func(p *Provider) {
+		p.changeInterval = interval
+	}
+}
+
+// WithHealthLogInterval makes the provider log the number of features it
+// holds, its data source mode and the time features were last updated, at
+// info level and the given interval, from Init until Shutdown. This surfaces
+// a data source that loaded no flags without failing.
+func WithHealthLogInterval(interval time.Duration) Option {
+	return func(p *Provider) {
+		p.healthInterval = interval
+	}
+}
+
+// WithLogger sets the logger for warnings about evaluations, such as attributes
+// that had to be dropped. The default is slog's default logger. See SetLogger
+// to replace it at runtime.
+func WithLogger(logger *slog.Logger) Option {
+	return func(p *Provider) {
+		p.sinks.logger.Store(logger)
+	}
+}
+
+// WithWarningRateLimit rate-limits the warnings logged for evaluations of
+// missing flags and flags of another type than requested, which can flood the
+// logs in a hot loop. Warnings for the same flag and error code are allowed
+// in bursts of up to burst, then one per interval. Once warnings were
+// suppressed, the next one logged is preceded by a line counting them. By
+// default every warning is logged.
+func WithWarningRateLimit(burst int, interval time.Duration) Option {
+	return func(p *Provider) {
+		p.warnLimiter = newWarningLimiter(burst, interval)
+	}
+}
+
+// WithEvaluationHistory keeps the last size evaluations, with a fingerprint
+// of their attributes and their outcome, for RecentEvaluations.
+func WithEvaluationHistory(size int) Option {
+	return func(p *Provider) {
+		if size > 0 {
+			p.history = newEvaluationHistory(size)
+		}
+	}
+}
+
+// WithKillSwitchFlag gates all evaluations behind a master kill switch flag.
+// Before each evaluation the kill switch is evaluated with the same context;
+// if it resolves to false, the caller's default is returned with the DISABLED
+// reason and a "killSwitch" metadata entry, and the flag isn't evaluated.
+func WithKillSwitchFlag(flag string) Option {
+	return func(p *Provider) {
+		p.killSwitchFlag = flag
+	}
+}
+
+// WithKnownFlags declares the flags the application expects GrowthBook to
+// define. GrowthBook leaves features that are disabled in the SDK connection's
+// environment out of the features it serves, so they can't be told apart from
+// flags that don't exist. A known flag that's missing resolves to the caller's
+// default with the DISABLED reason instead of FLAG_NOT_FOUND.
+func WithKnownFlags(flags []string) Option {
+	return func(p *Provider) {
+		p.knownFlags = make(map[string]bool, len(flags))
+		for _, flag := range flags {
+			p.knownFlags[flag] = true
+		}
+	}
+}
+
+// WithSplitReasonForExperiments makes values assigned by a GrowthBook
+// experiment resolve with the SPLIT reason, which OpenFeature uses for
+// pseudorandom assignments. Forced values, overrides and forced variations
+// keep TARGETING_MATCH. Disabled by default, for backward compatibility, so
+// experiment assignments resolve with TARGETING_MATCH.
+func WithSplitReasonForExperiments(enabled bool) Option {
+	return func(p *Provider) {
+		p.splitReason = enabled
+	}
+}
+
+// WithDevMode enables dev mode for QA environments. In dev mode, the flag
+// values set with WithDevOverrides win over the dashboard, and the query
+// string overrides of a URL passed with DevURL force experiment variations.
+// Results forced this way carry "devOverride" or "forcedVariation" metadata.
+// It should never be enabled in production.
+func WithDevMode(enabled bool) Option {
+	return func(p *Provider) {
+		p.devMode = enabled
+	}
+}
+
+// WithDevOverrides forces the values of flags, by flag key, while dev mode is
+// enabled with WithDevMode.
+func WithDevOverrides(overrides map[string]interface{}) Option {
+	return func(p *Provider) {
+		p.devOverrides = overrides
+	}
+}
+
+// WithRequiredAttributes makes evaluations fail with a TARGETING_KEY_MISSING
+// error naming the missing attributes when any of the given attributes is
+// missing from the merged evaluation attributes, instead of silently
+// evaluating without them. Nested attributes can be given in dotted form
+// (e.g. "company.id").
+func WithRequiredAttributes(keys []string) Option {
+	return func(p *Provider) {
+		p.requiredAttrs = keys
+	}
+}
+
+// WithHashAttributeShortCircuit makes evaluations of flags whose rules are all
+// experiments return the flag's default value without evaluating the flag
+// when the evaluation attributes lack the hash attribute of every experiment,
+// as GrowthBook can't include the user in any of them. This saves building a
+// client and running the rules on hot paths serving anonymous traffic. Like
+// WithRequiredAttributes, it's meant for strict setups; unlike it, the result
+// isn't an error but the DEFAULT reason with "skippedNoHashAttribute"
+// metadata.
+//
+// Flags with forced variations, a fallback hash attribute or prerequisites are
+// always evaluated. Forced variations configured on the GrowthBook client
+// itself aren't visible to the provider, so don't combine them with this option.
+func WithHashAttributeShortCircuit(enabled bool) Option {
+	return func(p *Provider) {
+		p.hashShortCircuit = enabled
+	}
+}
+
+// WithReservedKeyMapping sets how "$"-prefixed evaluation context keys, which
+// OpenFeature reserves for keys such as "$groups", are passed to GrowthBook.
+// mapping returns the attribute key to pass the value under, or "" to drop
+// it. By default these keys are dropped. The mapped keys are subject to the
+// attribute allowlist.
+func WithReservedKeyMapping(mapping func(key string) string) Option {
+	return func(p *Provider) {
+		p.reservedKeys = mapping
+	}
+}
+
+// WithAttributeAllowlist limits the evaluation context attributes passed to
+// GrowthBook to the given keys, so attributes such as email addresses can't
+// be bucketed or targeted on by accident. Other context attributes are dropped
+// and logged at debug level. The targeting key is passed as "id" only if "id"
+// is listed. Static hints, persistent attributes and the attributes of clones
+// are set by the application and aren't filtered. Attributes added by an
+// attribute resolver are context attributes and must be listed too.
+func WithAttributeAllowlist(keys []string) Option {
+	return func(p *Provider) {
+		p.attrAllowlist = make(map[string]bool, len(keys))
+		for _, key := range keys {
+			p.attrAllowlist[key] = true
+		}
+	}
+}
+
+// WithAttributeNormalizer normalizes the string values of the given
+// attributes with fn before evaluation, e.g. with strings.ToLower so an
+// "email" of "User@Example.com" matches a condition on "user@example.com".
+// It applies to the evaluation context and persistent attributes, and can be
+// given several times for different keys; a later normalizer for the same key
+// replaces an earlier one. Values that aren't strings, and values whose
+// normalizer panics, are left alone.
+func WithAttributeNormalizer(keys []string, fn func(string) string) Option {
+	return func(p *Provider) {
+		if p.normalizers == nil {
+			p.normalizers = make(map[string]func(string) string)
+		}
+		for _, key := range keys {
+			p.normalizers[key] = fn
+		}
+	}
+}
+
+// WithBooleanAttributeCoercion converts the string values "true" and "1" of
+// the given attributes to true, and "false" and "0" to false, before
+// evaluation, so attributes taken from query parameters or headers match
+// conditions on booleans. Coercion runs after the attribute normalizers, and
+// other values are left alone. It can be given several times for different
+// keys.
+func WithBooleanAttributeCoercion(keys []string) Option {
+	return func(p *Provider) {
+		if p.boolAttrs == nil {
+			p.boolAttrs = make(map[string]bool)
+		}
+		for _, key := range keys {
+			p.boolAttrs[key] = true
+		}
+	}
+}
+
+// WithTimeAttribute sets the attribute key under which every evaluation gets
+// the current time of the provider's clock, as an RFC 3339 UTC string such as
+// "2025-06-01T09:00:00Z". Conditions can then gate rules by time, e.g.
+// {"now": {"$gte": "2025-06-01T00:00:00Z"}}, as strings in this format
+// compare in time order, and EvaluateAt can preview them. An attribute of the
+// same key in the evaluation context takes precedence. With the evaluation
+// cache, a cached result may lag the time by up to the cache TTL.
+func WithTimeAttribute(key string) Option {
+	return func(p *Provider) {
+		p.timeAttr = key
+	}
+}
+
+// WithTreatEmptyObjectAsDefault makes object flags whose value is an empty
+// object or array resolve to the caller's default value, with the DEFAULT
+// reason and "emptyObject" metadata, for callers that treat "no config" as
+// the default. Disabled by default, so empty values are returned as they are.
+func WithTreatEmptyObjectAsDefault(enabled bool) Option {
+	return func(p *Provider) {
+		p.emptyAsDefault = enabled
+	}
+}
+
+// WithMaxObjectSize sets the size, in bytes of JSON, above which object and
+// array values aren't kept by the evaluation cache or written to the audit
+// log, so large blobs aren't retained. Skipped values are logged at debug
+// level. 0, the default, sets no limit.
+func WithMaxObjectSize(bytes int) Option {
+	return func(p *Provider) {
+		p.maxObjectSize = bytes
+	}
+}
+
+// WithRejectOversizedObjects makes object flags larger than the size set with
+// WithMaxObjectSize resolve to the default value with a PARSE_ERROR, rather
+// than being returned uncached.
+func WithRejectOversizedObjects(enabled bool) Option {
+	return func(p *Provider) {
+		p.rejectOversized = enabled
+	}
+}
+
+// WithObjectDecoder sets the function DecodeObject decodes the JSON of object
+// flags with, e.g. a decoder rejecting unknown fields. The default is
+// json.Unmarshal.
+func WithObjectDecoder(decode func(data []byte, v interface{}) error) Option {
+	return func(p *Provider) {
+		p.objectDecoder = decode
+	}
+}
+
+// WithFeatureLoader sets a loader fetching feature definitions from a custom
+// source, such as a team's own config service, instead of GrowthBook's CDN.
+// The loader is called by Init, within the load timeout, and by Refresh, and
+// the features it returns replace those of the GrowthBook client, whose data
+// source isn't waited for. The client should be created without one.
+func WithFeatureLoader(loader FeatureLoader) Option {
+	return func(p *Provider) {
+		p.featureLoader = loader
+	}
+}
+
+// WithPreviewMode serves draft feature definitions, so unpublished changes can
+// be previewed before they go live. GrowthBook's SDK endpoints only serve
+// published features, so the drafts are fetched by loader, which is given
+// token to authenticate with wherever they're kept. Like WithFeatureLoader,
+// the loader is called by Init and by Refresh, and its features replace those
+// of the GrowthBook client. Results are marked with "preview" metadata.
+func WithPreviewMode(token string, loader PreviewLoader) Option {
+	return func(p *Provider) {
+		p.featureLoader = func(ctx context.Context) (json.RawMessage, error) {
+			return loader(ctx, token)
+		}
+		p.preview = true
+	}
+}
+
+// WithClock sets the clock used for everything time-dependent, such as the
+// staleness TTL, Info's LastLoaded and the timestamps of recorded
+// evaluations. The default is time.Now. It's meant for deterministic tests.
+func WithClock(now func() time.Time) Option {
+	return func(p *Provider) {
+		if now != nil {
+			p.now = now
+		}
+	}
+}
+
+// WithRemoteEval makes the provider evaluate flags remotely: the attributes of
+// each evaluation are posted to GrowthBook's remote evaluation endpoint, which
+// does the targeting and bucketing server-side and answers with the evaluated
+// features, so rules and experiment weights never reach the process. This
+// costs one HTTP round trip per evaluation, and the features loaded into the
+// GrowthBook client are not used. The endpoint is set with
+// WithRemoteEvalEndpoint, as the client doesn't expose its API host and key.
+func WithRemoteEval(enabled bool) Option {
+	return func(p *Provider) {
+		p.remoteEval = enabled
+	}
+}
+
+// WithRemoteEvalEndpoint sets the API host and client key used for remote
+// evaluation (see WithRemoteEval), and the HTTP client requests are sent
+// with. A nil httpClient means http.DefaultClient.
+func WithRemoteEvalEndpoint(apiHost, clientKey string, httpClient *http.Client) Option {
+	return func(p *Provider) {
+		if httpClient == nil {
+			httpClient = http.DefaultClient
+		}
+		p.remoteEndpoint = &remoteEvalEndpoint{
+			apiHost:    apiHost,
+			clientKey:  clientKey,
+			httpClient: httpClient,
+		}
+	}
+}
+
+// WithValueTransform sets a transform applied to the resolved value of flag
+// before it's returned, such as clamping a number to a safe range. The
+// transform sees the typed value (e.g. an int64 for IntEvaluation) and must
+// return a value of the same type, otherwise it's ignored. The reason, variant
+// and metadata of the result are kept. Defaults returned for unresolved flags
+// aren't transformed.
+func WithValueTransform(flag string, transform func(value interface{}) interface{}) Option {
+	return func(p *Provider) {
+		if p.transforms == nil {
+			p.transforms = make(map[string]func(value interface{}) interface{})
+		}
+		p.transforms[flag] = transform
+	}
+}
+
+// WithFallbackProvider sets a provider, typically a static in-code one, that
+// resolves flags GrowthBook reports as not found, or all flags while this
+// provider isn't ready. Results it serves carry "servedBy": "fallback" in their
+// flag metadata. If the fallback can't resolve the flag either, the caller's
+// default is returned with this provider's error. It backs the typed
+// evaluations, ObjectEvaluationJSON and DecodeObject, but not the diagnostics
+// that report GrowthBook's own evaluation: ExplainFlag, ExplainAll,
+// ExperimentResultFor and VariationDistribution.
+func WithFallbackProvider(provider openfeature.FeatureProvider) Option {
+	return func(p *Provider) {
+		p.fallbackProvider = provider
+	}
+}
+
+// WithInitAttributes makes the attributes of the evaluation context passed to
+// Init apply to every evaluation, beneath the persistent attributes and the
+// evaluation context. They're disabled by default, in which case Init only
+// loads features. Either way the GrowthBook client isn't changed, so
+// attributes managed on it by other code are left alone.
+func WithInitAttributes(enabled bool) Option {
+	return func(p *Provider) {
+		p.initAttributes = enabled
+	}
+}
+
+// WithGroupMembership forces whether the user being evaluated is a member of
+// the given saved groups, by group ID, so tests can exercise $inGroup and
+// $notInGroup conditions without building real group definitions. While it's
+// set, saved groups missing from the map are treated as undefined and every
+// evaluation builds its own GrowthBook client. It's meant for tests only.
+func WithGroupMembership(membership map[string]bool) Option {
+	return func(p *Provider) {
+		p.groupMembership = membership
+	}
+}
+
+// WithAuditLog writes a JSON line for every evaluation to w, with the time,
+// flag, value, reason, variant, error code and a fingerprint of the attributes
+// (see RecentEvaluations), for an append-only record of flag decisions. Lines
+// are written synchronously by the evaluating goroutine, one at a time. Write
+// errors are reported through the logger.
+func WithAuditLog(w io.Writer) Option {
+	return func(p *Provider) {
+		p.auditLog = newAuditLog(w, 0, p.log)
+	}
+}
+
+// WithAsyncAuditLog is WithAuditLog with lines queued in a buffer of
+// bufferSize lines and written by a separate goroutine, keeping slow writers
+// off the evaluation path. Evaluations block while the buffer is full rather
+// than dropping lines. Shutdown waits for the queued lines to be written.
+func WithAsyncAuditLog(w io.Writer, bufferSize int) Option {
+	return func(p *Provider) {
+		p.auditLog = newAuditLog(w, bufferSize, p.log)
+	}
+}
+
+// WithOmitZeroAttributes drops attributes of the evaluation context, and
+// persistent attributes, that are nil or hold a zero value (empty string, 0,
+// false) before evaluating, so they count as unset. GrowthBook treats zero
+// values as present, e.g. {"$exists": true} matches an empty string. Disabled
+// by default.
+func WithOmitZeroAttributes(enabled bool) Option {
+	return func(p *Provider) {
+		p.omitZeroAttrs = enabled
+	}
+}
+
+// WithEvaluationCache caches the results of typed evaluations for ttl, per
+// flag and attributes, and serves repeated evaluations from the cache with the
+// CACHED reason and the original reason under "originalReason" metadata. The
+// cache is emptied whenever the client's features change. Only values served
+// by the flag itself are cached, and exposures aren't reported again for
+// cache hits.
+func WithEvaluationCache(ttl time.Duration) Option {
+	return func(p *Provider) {
+		if ttl > 0 {
+			p.cache = newEvaluationCache(ttl)
+		}
+	}
+}
+
+// WithMetadataFilter sets a filter applied to the flag metadata of every
+// evaluation result before it's returned, to redact or allow-list keys such as
+// "source", "experiment" or "hashAttribute" that shouldn't reach clients. The
+// filter gets a copy of the metadata and its result replaces it. If the filter
+// panics, the result is returned without metadata. The rule ID reported as the
+// variant isn't metadata and isn't filtered.
+func WithMetadataFilter(filter func(md openfeature.FlagMetadata) openfeature.FlagMetadata) Option {
+	return func(p *Provider) {
+		p.metadataFilter = filter
+	}
+}
+
+// WithDomainName records the OpenFeature domain the provider is bound to with
+// openfeature.SetNamedProvider, so providers for different domains can be told
+// apart. Binding is still done by the OpenFeature SDK. The domain is added to
+// the provider's metadata name, to audit log lines and to the context passed
+// to the tracking callback (see DomainFromContext).
+func WithDomainName(domain string) Option {
+	return func(p *Provider) {
+		p.domain = domain
+	}
+}
+
+// WithProviderName sets the name reported by Metadata, so several providers
+// in one application can be told apart in logs. It defaults to
+// "GrowthBook Provider". The domain set with WithDomainName is still appended.
+func WithProviderName(name string) Option {
+	return func(p *Provider) {
+		p.providerName = name
+	}
+}
+
+// WithAttributeResolver sets a resolver that enriches the attributes of every
+// evaluation before flags are evaluated, e.g. to look up the plan of the
+// organization owning the team named by a "teamId" attribute, so conditions
+// can target attributes callers don't flatten into their contexts. The
+// resolver gets a copy of the evaluation's attributes and the context of the
+// evaluation. The attributes it returns are merged beneath the explicit ones,
+// which take precedence.
+//
+// The resolver is called once per evaluation, cache hits included, so
+// expensive lookups should be cached by the resolver. The evaluation cache is
+// keyed on the resolved attributes. If it panics, the evaluation proceeds with
+// the explicit attributes only.
+func WithAttributeResolver(resolver func(ctx context.Context, attrs map[string]interface{}) map[string]interface{}) Option {
+	return func(p *Provider) {
+		p.attrResolver = resolver
+	}
+}
+
+// WithAttributeSources sets sources of attributes for server-side request
+// enrichment, such as the caller's geo location or session, consulted in order
+// on every evaluation. Attributes returned by later sources override those of
+// earlier ones, and all are merged beneath the explicit attributes, which take
+// precedence. They are added before the resolver set with
+// WithAttributeResolver is called, so it sees them.
+//
+// A source that returns an error or panics is logged and skipped, and the
+// evaluation carries on with the other sources.
+func WithAttributeSources(sources ...AttributeSource) Option {
+	return func(p *Provider) {
+		p.attrSources = append(p.attrSources, sources...)
+	}
+}
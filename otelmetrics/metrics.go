@@ -0,0 +1,97 @@
+// Package otelmetrics exports the evaluation metrics of a GrowthBook provider
+// as OpenTelemetry metrics. It's a separate package so the OpenTelemetry API
+// is only linked into programs that use it.
+package otelmetrics
+
+import (
+	"context"
+	"time"
+
+	gbprovider "github.com/growthbook/growthbook-openfeature-provider-go"
+	"github.com/open-feature/go-sdk/openfeature"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ScopeName is the instrumentation scope of the instruments
+const ScopeName = "github.com/growthbook/growthbook-openfeature-provider-go/otelmetrics"
+
+// Instrument names
+const (
+	EvaluationsName   = "feature_flag.evaluations"
+	DefaultServedName = "feature_flag.default_served"
+	DurationName      = "feature_flag.evaluation.duration"
+)
+
+// Attribute keys, following OpenTelemetry's semantic conventions for feature
+// flags
+const (
+	flagKey   = attribute.Key("feature_flag.key")
+	reasonKey = attribute.Key("feature_flag.result.reason")
+	errorKey  = attribute.Key("error.type")
+)
+
+// Metrics implements growthbook.Metrics and growthbook.LatencyMetrics with
+// OpenTelemetry instruments: counters of evaluations by flag and reason and of
+// defaults served, and a histogram of evaluation latency in seconds.
+type Metrics struct {
+	evaluations   metric.Int64Counter
+	defaultServed metric.Int64Counter
+	duration      metric.Float64Histogram
+}
+
+// New creates the instruments with a meter of mp
+func New(mp metric.MeterProvider) (*Metrics, error) {
+	meter := mp.Meter(ScopeName)
+	evaluations, err := meter.Int64Counter(EvaluationsName,
+		metric.WithDescription("Feature flag evaluations by flag and reason"),
+		metric.WithUnit("{evaluation}"))
+	if err != nil {
+		return nil, err
+	}
+	defaultServed, err := meter.Int64Counter(DefaultServedName,
+		metric.WithDescription("Feature flag evaluations that served the default value"),
+		metric.WithUnit("{evaluation}"))
+	if err != nil {
+		return nil, err
+	}
+	duration, err := meter.Float64Histogram(DurationName,
+		metric.WithDescription("Duration of feature flag evaluations"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+	return &Metrics{evaluations: evaluations, defaultServed: defaultServed, duration: duration}, nil
+}
+
+// WithMeterProvider is a provider option recording evaluation metrics with
+// the instruments of New. If they can't be created, the error is reported to
+// OpenTelemetry's global error handler and no metrics are recorded.
+func WithMeterProvider(mp metric.MeterProvider) gbprovider.Option {
+	m, err := New(mp)
+	if err != nil {
+		otel.Handle(err)
+		return func(*gbprovider.Provider) {}
+	}
+	return gbprovider.WithMetrics(m)
+}
+
+// CountEvaluation implements growthbook.Metrics
+func (m *Metrics) CountEvaluation(flag string, reason openfeature.Reason) {
+	m.evaluations.Add(context.Background(), 1, metric.WithAttributes(flagKey.String(flag), reasonKey.String(string(reason))))
+}
+
+// CountDefaultServed implements growthbook.Metrics
+func (m *Metrics) CountDefaultServed(flag string, reason openfeature.Reason, code openfeature.ErrorCode) {
+	attrs := []attribute.KeyValue{flagKey.String(flag), reasonKey.String(string(reason))}
+	if code != "" {
+		attrs = append(attrs, errorKey.String(string(code)))
+	}
+	m.defaultServed.Add(context.Background(), 1, metric.WithAttributes(attrs...))
+}
+
+// ObserveLatency implements growthbook.LatencyMetrics
+func (m *Metrics) ObserveLatency(flag string, reason openfeature.Reason, latency time.Duration) {
+	m.duration.Record(context.Background(), latency.Seconds(), metric.WithAttributes(flagKey.String(flag), reasonKey.String(string(reason))))
+}
@@ -0,0 +1,89 @@
+package otelmetrics
+
+import (
+	"context"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	gbprovider "github.com/growthbook/growthbook-openfeature-provider-go"
+	"github.com/open-feature/go-sdk/openfeature"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestInstrumentsRecordEvaluations(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"new-checkout": {"defaultValue": false, "rules": [{"condition": {"country": "NZ"}, "force": true}]}
+	}`))
+	provider := gbprovider.NewProvider(gbClient, false, WithMeterProvider(mp))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	ctx := context.Background()
+	provider.BooleanEvaluation(ctx, "new-checkout", false, openfeature.FlattenedContext{"country": "NZ"})
+	provider.BooleanEvaluation(ctx, "new-checkout", false, openfeature.FlattenedContext{"country": "NZ"})
+	provider.BooleanEvaluation(ctx, "new-checkout", false, openfeature.FlattenedContext{"country": "US"})
+	provider.BooleanEvaluation(ctx, "missing", false, nil)
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatalf("Failed to collect metrics: %v", err)
+	}
+	metrics := map[string]metricdata.Aggregation{}
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+
+	evaluations, ok := metrics[EvaluationsName].(metricdata.Sum[int64])
+	if !ok {
+		t.Fatalf("Expected an int64 sum for %s, got %T", EvaluationsName, metrics[EvaluationsName])
+	}
+	counts := map[string]int64{}
+	for _, point := range evaluations.DataPoints {
+		flag, _ := point.Attributes.Value(flagKey)
+		reason, _ := point.Attributes.Value(reasonKey)
+		counts[flag.AsString()+"/"+reason.AsString()] = point.Value
+	}
+	expected := map[string]int64{
+		"new-checkout/TARGETING_MATCH": 2,
+		"new-checkout/DEFAULT":         1,
+		"missing/ERROR":                1,
+	}
+	for key, count := range expected {
+		if counts[key] != count {
+			t.Errorf("Expected %d evaluations for %s, got %d (all: %v)", count, key, counts[key], counts)
+		}
+	}
+
+	defaults, ok := metrics[DefaultServedName].(metricdata.Sum[int64])
+	if !ok {
+		t.Fatalf("Expected an int64 sum for %s, got %T", DefaultServedName, metrics[DefaultServedName])
+	}
+	missing := attribute.NewSet(flagKey.String("missing"), reasonKey.String("ERROR"), errorKey.String(string(openfeature.FlagNotFoundCode)))
+	var found bool
+	for _, point := range defaults.DataPoints {
+		if point.Attributes.Equals(&missing) {
+			found = point.Value == 1
+		}
+	}
+	if !found {
+		t.Errorf("Expected a default served for the missing flag with its error code, got %+v", defaults.DataPoints)
+	}
+
+	duration, ok := metrics[DurationName].(metricdata.Histogram[float64])
+	if !ok {
+		t.Fatalf("Expected a float64 histogram for %s, got %T", DurationName, metrics[DurationName])
+	}
+	var observed uint64
+	for _, point := range duration.DataPoints {
+		observed += point.Count
+	}
+	if observed != 4 {
+		t.Errorf("Expected 4 latency observations, got %d", observed)
+	}
+}
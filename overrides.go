@@ -0,0 +1,113 @@
+package growthbook
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	gb "github.com/growthbook/growthbook-golang"
+)
+
+// overrideSource is the source of results forced with SetOverride
+const overrideSource gb.FeatureResultSource = "override"
+
+// overrideStore holds the flag values forced with SetOverride. It's read on
+// every evaluation and written by admin endpoints, so reads only take the
+// read lock for a map lookup.
+type overrideStore struct {
+	mu     sync.RWMutex
+	values map[string]interface{}
+}
+
+func newOverrideStore() *overrideStore {
+	return &overrideStore{values: make(map[string]interface{})}
+}
+
+func (s *overrideStore) get(flag string) (interface{}, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok := s.values[flag]
+	return value, ok
+}
+
+func (s *overrideStore) set(flag string, value interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[flag] = value
+}
+
+func (s *overrideStore) clear(flag string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, flag)
+}
+
+// setAll sets all of values under a single lock, so evaluations see either
+// none or all of them
+func (s *overrideStore) setAll(values map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for flag, value := range values {
+		s.values[flag] = value
+	}
+}
+
+func (s *overrideStore) clearAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.values)
+}
+
+// SetOverride forces flag to resolve to value for every evaluation, whatever
+// the dashboard says, until ClearOverride is called, e.g. from an admin
+// endpoint during an incident. Results carry the TARGETING_MATCH reason and
+// "override" metadata. Overrides take precedence over dev mode overrides and
+// are shared with clones. It's safe to call concurrently with evaluations.
+func (p *Provider) SetOverride(flag string, value interface{}) {
+	p.overrides.set(flag, value)
+	p.cache.clear()
+}
+
+// ClearOverride removes the override of flag set with SetOverride, if any
+func (p *Provider) ClearOverride(flag string) {
+	p.overrides.clear(flag)
+	p.cache.clear()
+}
+
+// LoadOverrides parses overridesJSON, a JSON object of flag keys to values,
+// e.g. read from a local "overrides.json" file in development, and sets them
+// all as overrides at once, like SetOverride. Values aren't checked against
+// the flags' types: a value of the wrong type resolves with a type mismatch
+// when the flag is evaluated. Nothing is set if the JSON is invalid.
+func (p *Provider) LoadOverrides(overridesJSON string) error {
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(overridesJSON), &values); err != nil {
+		return fmt.Errorf("failed to parse overrides: %w", err)
+	}
+	p.overrides.setAll(values)
+	p.cache.clear()
+	return nil
+}
+
+// ClearAllOverrides removes every override set with SetOverride or
+// LoadOverrides
+func (p *Provider) ClearAllOverrides() {
+	p.overrides.clearAll()
+	p.cache.clear()
+}
+
+// overrideResult returns the result of a flag forced with SetOverride or, in
+// dev mode, with WithDevOverrides, or nil if the flag isn't overridden
+func (p *Provider) overrideResult(flag string) *gb.FeatureResult {
+	value, ok := p.overrides.get(flag)
+	if !ok {
+		return p.devOverride(flag)
+	}
+	on := truthy(value)
+	return &gb.FeatureResult{
+		Value:  value,
+		Source: overrideSource,
+		On:     on,
+		Off:    !on,
+	}
+}
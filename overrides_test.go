@@ -0,0 +1,125 @@
+package growthbook
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestSetOverride(t *testing.T) {
+	provider := setupTestProvider(WithEvaluationCache(time.Minute))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	evalCtx := openfeature.FlattenedContext{"targetingKey": "user-1"}
+
+	// Cached before the override is set
+	_ = provider.StringEvaluation(context.Background(), "string-flag", "", evalCtx)
+
+	provider.SetOverride("string-flag", "overridden")
+	result := provider.StringEvaluation(context.Background(), "string-flag", "", evalCtx)
+	if result.Value != "overridden" || result.Reason != openfeature.TargetingMatchReason {
+		t.Errorf("Expected the override, got %q (%s)", result.Value, result.Reason)
+	}
+	if result.FlagMetadata["override"] != true {
+		t.Errorf("Expected override metadata, got %v", result.FlagMetadata)
+	}
+
+	// Clones share the overrides
+	clone := provider.Clone(map[string]interface{}{"country": "NZ"})
+	if cloned := clone.StringEvaluation(context.Background(), "string-flag", "", nil); cloned.Value != "overridden" {
+		t.Errorf("Expected the clone to see the override, got %q", cloned.Value)
+	}
+
+	provider.ClearOverride("string-flag")
+	if result := provider.StringEvaluation(context.Background(), "string-flag", "", evalCtx); result.Value != "default-string" {
+		t.Errorf("Expected the flag's value after clearing the override, got %q", result.Value)
+	}
+}
+
+func TestLoadOverrides(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	ctx := context.Background()
+
+	err := provider.LoadOverrides(`{
+		"bool-flag": false,
+		"string-flag": "from-file",
+		"int-flag": 7,
+		"object-flag": {"key": "local"}
+	}`)
+	if err != nil {
+		t.Fatalf("Expected the overrides to load, got %v", err)
+	}
+
+	if result := provider.BooleanEvaluation(ctx, "bool-flag", true, nil); result.Value || result.FlagMetadata["override"] != true {
+		t.Errorf("Expected the bool override to win, got %v (%v)", result.Value, result.FlagMetadata)
+	}
+	if result := provider.StringEvaluation(ctx, "string-flag", "", nil); result.Value != "from-file" {
+		t.Errorf("Expected the string override to win, got %q", result.Value)
+	}
+	if result := provider.IntEvaluation(ctx, "int-flag", 0, nil); result.Value != 7 {
+		t.Errorf("Expected the int override to win, got %d", result.Value)
+	}
+	if result := provider.ObjectEvaluation(ctx, "object-flag", nil, nil); result.Value.(map[string]interface{})["key"] != "local" {
+		t.Errorf("Expected the object override to win, got %v", result.Value)
+	}
+
+	// Types are only checked when the flag is evaluated
+	_ = provider.LoadOverrides(`{"number-flag": "not a number"}`)
+	if result := provider.FloatEvaluation(ctx, "number-flag", 1, nil); result.ResolutionDetail().ErrorCode != openfeature.TypeMismatchCode {
+		t.Errorf("Expected a type mismatch for a mistyped override, got %v (%q)", result.Value, result.ResolutionDetail().ErrorCode)
+	}
+
+	if err := provider.LoadOverrides(`{"bool-flag": tru`); err == nil {
+		t.Error("Expected invalid JSON to be rejected")
+	}
+	if result := provider.BooleanEvaluation(ctx, "bool-flag", true, nil); result.Value {
+		t.Error("Expected the loaded overrides to be kept after invalid JSON")
+	}
+
+	provider.ClearAllOverrides()
+	if result := provider.StringEvaluation(ctx, "string-flag", "", nil); result.Value != "default-string" {
+		t.Errorf("Expected the dashboard value after clearing all overrides, got %q", result.Value)
+	}
+	if result := provider.FloatEvaluation(ctx, "number-flag", 0, nil); result.Value != 42.5 {
+		t.Errorf("Expected the dashboard value after clearing all overrides, got %v", result.Value)
+	}
+}
+
+func TestOverridesConcurrentAccess(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				result := provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+				if result.Error() != nil {
+					t.Errorf("Unexpected error: %v", result.Error())
+					return
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 1000; i++ {
+		if i%2 == 0 {
+			provider.SetOverride("bool-flag", false)
+		} else {
+			provider.ClearOverride("bool-flag")
+		}
+	}
+	close(done)
+	wg.Wait()
+}
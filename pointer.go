@@ -0,0 +1,95 @@
+package growthbook
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// ObjectFieldEvaluation evaluates an object flag and returns the value at
+// pointer, an RFC 6901 JSON Pointer such as "/checkout/limits/0", for callers
+// that only need one field of a large object. The empty pointer refers to the
+// whole value. Results carry the pointer under "pointer" in their metadata.
+//
+// defaultValue is returned with the flag's error or reason if the flag can't
+// be resolved, with the DEFAULT reason and "pointerMissing" metadata if the
+// flag has no value at pointer, and with a General error if pointer is
+// invalid.
+func (p *Provider) ObjectFieldEvaluation(ctx context.Context, flag string, pointer string, defaultValue interface{}, evalCtx openfeature.FlattenedContext) openfeature.InterfaceResolutionDetail {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return openfeature.InterfaceResolutionDetail{
+			Value: defaultValue,
+			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
+				ResolutionError: openfeature.NewGeneralResolutionError(fmt.Sprintf("invalid JSON pointer %q: %v", pointer, err)),
+				Reason:          openfeature.ErrorReason,
+			},
+		}
+	}
+
+	result := p.ObjectEvaluation(ctx, flag, defaultValue, evalCtx)
+	if result.Error() != nil {
+		return result
+	}
+	value, ok := lookupPointer(result.Value, tokens)
+	if !ok {
+		detail := withMetadata(result.ProviderResolutionDetail, "pointer", pointer)
+		detail.Reason = openfeature.DefaultReason
+		return openfeature.InterfaceResolutionDetail{
+			Value:                    defaultValue,
+			ProviderResolutionDetail: withMetadata(detail, "pointerMissing", true),
+		}
+	}
+	return openfeature.InterfaceResolutionDetail{
+		Value:                    value,
+		ProviderResolutionDetail: withMetadata(result.ProviderResolutionDetail, "pointer", pointer),
+	}
+}
+
+// parsePointer splits an RFC 6901 JSON Pointer into its unescaped reference
+// tokens
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("a pointer must be empty or start with '/'")
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		// "~01" is "~1", so "~1" must be unescaped before "~0"
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// lookupPointer returns the value at the reference tokens of a JSON Pointer
+// within value, and whether there is one
+func lookupPointer(value interface{}, tokens []string) (interface{}, bool) {
+	for _, token := range tokens {
+		switch v := derefValue(value).(type) {
+		case map[string]interface{}:
+			field, ok := v[token]
+			if !ok {
+				return nil, false
+			}
+			value = field
+		case []interface{}:
+			// Array indexes have no leading zeros, and "-" is past the end
+			if token == "" || (len(token) > 1 && token[0] == '0') {
+				return nil, false
+			}
+			index, err := strconv.ParseUint(token, 10, 0)
+			if err != nil || index >= uint64(len(v)) {
+				return nil, false
+			}
+			value = v[index]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
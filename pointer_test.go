@@ -0,0 +1,85 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+const pointerFeatures = `{
+	"checkout": {
+		"defaultValue": {
+			"limits": {"daily": 500, "per-order": [100, 250]},
+			"a/b": {"m~n": "escaped"},
+			"enabled": true
+		}
+	},
+	"banner": {"defaultValue": "hello"}
+}`
+
+func TestObjectFieldEvaluation(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(pointerFeatures))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	tests := []struct {
+		pointer  string
+		expected interface{}
+	}{
+		{"/limits/daily", 500.0},
+		{"/limits/per-order/1", 250.0},
+		{"/a~1b/m~0n", "escaped"},
+		{"/enabled", true},
+	}
+	for _, tt := range tests {
+		result := provider.ObjectFieldEvaluation(context.Background(), "checkout", tt.pointer, nil, nil)
+		if result.Value != tt.expected {
+			t.Errorf("Expected %v at %s, got %v (%v)", tt.expected, tt.pointer, result.Value, result.Error())
+		}
+		if result.FlagMetadata["pointer"] != tt.pointer {
+			t.Errorf("Expected the pointer in the metadata, got %v", result.FlagMetadata)
+		}
+	}
+
+	// The empty pointer is the whole value
+	whole := provider.ObjectFieldEvaluation(context.Background(), "checkout", "", nil, nil)
+	if object, ok := whole.Value.(map[string]interface{}); !ok || object["enabled"] != true {
+		t.Errorf("Expected the whole object, got %v", whole.Value)
+	}
+}
+
+func TestObjectFieldEvaluationMissingPath(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(pointerFeatures))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	for _, pointer := range []string{"/limits/weekly", "/limits/per-order/2", "/limits/per-order/01", "/limits/per-order/-", "/enabled/value"} {
+		result := provider.ObjectFieldEvaluation(context.Background(), "checkout", pointer, "fallback", nil)
+		if result.Value != "fallback" {
+			t.Errorf("Expected the default at %s, got %v", pointer, result.Value)
+		}
+		if result.Reason != openfeature.DefaultReason || result.Error() != nil {
+			t.Errorf("Expected the DEFAULT reason without error at %s, got %s (%v)", pointer, result.Reason, result.Error())
+		}
+		if result.FlagMetadata["pointerMissing"] != true {
+			t.Errorf("Expected pointerMissing metadata at %s, got %v", pointer, result.FlagMetadata)
+		}
+	}
+
+	// A field of a scalar flag is missing too
+	if result := provider.ObjectFieldEvaluation(context.Background(), "banner", "/text", "fallback", nil); result.Value != "fallback" {
+		t.Errorf("Expected the default for a scalar flag, got %v", result.Value)
+	}
+
+	missing := provider.ObjectFieldEvaluation(context.Background(), "missing-flag", "/limits", "fallback", nil)
+	if missing.Value != "fallback" || missing.ResolutionDetail().ErrorCode != openfeature.FlagNotFoundCode {
+		t.Errorf("Expected the default with FlagNotFound, got %v (%v)", missing.Value, missing.Error())
+	}
+
+	invalid := provider.ObjectFieldEvaluation(context.Background(), "checkout", "limits", "fallback", nil)
+	if invalid.Value != "fallback" || invalid.ResolutionDetail().ErrorCode != openfeature.GeneralCode {
+		t.Errorf("Expected the default with a General error for an invalid pointer, got %v (%v)", invalid.Value, invalid.Error())
+	}
+}
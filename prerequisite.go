@@ -0,0 +1,52 @@
+package growthbook
+
+import (
+	"context"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// valuelessDetail describes the result of a flag that resolved without a
+// value. A flag gated off by a prerequisite carries the key of the parent
+// flag whose condition wasn't met as "gatedByParent" metadata.
+func (p *Provider) valuelessDetail(ctx context.Context, flag string, feature *gb.FeatureResult, probe *ruleProbe) openfeature.ProviderResolutionDetail {
+	detail := p.createDefaultResolutionDetail()
+	if feature.Source != gb.PrerequisiteResultSource {
+		return detail
+	}
+	if parent := p.gatingParent(ctx, flag, probe); parent != "" {
+		if detail.FlagMetadata == nil {
+			detail.FlagMetadata = openfeature.FlagMetadata{}
+		}
+		detail.FlagMetadata["gatedByParent"] = parent
+	}
+	return detail
+}
+
+// gatingParent returns the parent flag whose gating condition turned flag
+// off, or "" if it can't be told. GrowthBook doesn't report it, and its
+// conditions can only be evaluated by the SDK, so the rules' parent
+// conditions are replayed with probe, one more at a time, on a probe feature
+// until the probe is gated off too.
+func (p *Provider) gatingParent(ctx context.Context, flag string, probe *ruleProbe) string {
+	feature := p.gbClient.Features()[flag]
+	if feature == nil || !probe.ready(ctx) {
+		return ""
+	}
+
+	// Evaluation stops at the first rule gated off, at its first gating
+	// parent whose condition fails
+	for _, rule := range feature.Rules {
+		for i := range rule.ParentConditions {
+			source := probe.eval(ctx, probeFeature, gb.FeatureRule{
+				ParentConditions: rule.ParentConditions[:i+1],
+				Force:            true,
+			})
+			if source == gb.PrerequisiteResultSource {
+				return rule.ParentConditions[i].Id
+			}
+		}
+	}
+	return ""
+}
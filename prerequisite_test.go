@@ -0,0 +1,83 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+const prerequisiteFeatures = `{
+	"new-checkout": {
+		"defaultValue": false,
+		"rules": [{"condition": {"country": "US"}, "force": true}]
+	},
+	"checkout-banner": {
+		"defaultValue": "hidden",
+		"rules": [{
+			"parentConditions": [{"id": "new-checkout", "condition": {"value": true}, "gate": true}]
+		}, {
+			"force": "shown"
+		}]
+	}
+}`
+
+func TestPrerequisiteGatedByParent(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(prerequisiteFeatures))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.StringEvaluation(context.Background(), "checkout-banner", "default", openfeature.FlattenedContext{"country": "FR"})
+	if result.Value != "default" {
+		t.Errorf("Expected the default value for a gated flag, got %q", result.Value)
+	}
+	if result.Reason != openfeature.DefaultReason {
+		t.Errorf("Expected the DEFAULT reason, got %s", result.Reason)
+	}
+	if result.FlagMetadata["gatedByParent"] != "new-checkout" {
+		t.Errorf("Expected gatedByParent new-checkout, got %v", result.FlagMetadata)
+	}
+}
+
+func TestPrerequisiteMet(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(prerequisiteFeatures))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.StringEvaluation(context.Background(), "checkout-banner", "default", openfeature.FlattenedContext{"country": "US"})
+	if result.Value != "shown" {
+		t.Errorf("Expected the flag to pass its prerequisite, got %q", result.Value)
+	}
+	if _, ok := result.FlagMetadata["gatedByParent"]; ok {
+		t.Errorf("Expected no gatedByParent metadata, got %v", result.FlagMetadata)
+	}
+}
+
+func TestGatedByParentWithResolvedAttributes(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"checkout-live": {"defaultValue": true, "rules": [
+			{"condition": {"country": "FR"}, "force": false},
+			{"condition": {"now": {"$gte": "2030-06-01T00:00:00Z"}}, "force": false}
+		]},
+		"checkout-banner": {"defaultValue": "shown", "rules": [
+			{"parentConditions": [{"id": "checkout-live", "condition": {"value": true}, "gate": true}]}
+		]}
+	}`))
+	country := attributeSourceFunc(func(ctx context.Context) (map[string]interface{}, error) {
+		return map[string]interface{}{"country": "FR"}, nil
+	})
+	sourced := NewProvider(gbClient, false, WithAttributeSources(country))
+	_ = sourced.Init(openfeature.NewEvaluationContext("", nil))
+	if result := sourced.StringEvaluation(context.Background(), "checkout-banner", "default", nil); result.FlagMetadata["gatedByParent"] != "checkout-live" {
+		t.Errorf("Expected the sourced country to gate the flag, got %q %v", result.Value, result.FlagMetadata)
+	}
+
+	scheduled := NewProvider(gbClient, false, WithTimeAttribute("now"))
+	_ = scheduled.Init(openfeature.NewEvaluationContext("", nil))
+	result := scheduled.EvaluateAt(time.Date(2030, 7, 1, 0, 0, 0, 0, time.UTC), context.Background(), "checkout-banner", nil)
+	if result.FlagMetadata["gatedByParent"] != "checkout-live" {
+		t.Errorf("Expected the evaluation time to gate the flag, got %v %v", result.Value, result.FlagMetadata)
+	}
+}
@@ -0,0 +1,84 @@
+package growthbook
+
+import (
+	"context"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// probeFeature is the key the rules probed by gatingParent are evaluated under
+const probeFeature = "\x00prerequisite-probe"
+
+// ruleProbe evaluates single rules in place of a flag, on a client of its own
+// holding a copy of the client's features, to replay checks GrowthBook doesn't
+// report. Its client is built on first use and reused for every probe, so one
+// probe can serve all the flags of an evaluation context. It doesn't have the
+// client's saved groups, forced variations or dev URL, and isn't safe for
+// concurrent use.
+type ruleProbe struct {
+	provider *Provider
+	evalCtx  openfeature.FlattenedContext
+	built    bool
+	client   *gb.Client
+	features gb.FeatureMap
+	attrs    gb.Attributes
+}
+
+// newRuleProbe returns a probe evaluating rules with the attributes of
+// evalCtx, which must already be enriched with resolveAttributes
+func (p *Provider) newRuleProbe(evalCtx openfeature.FlattenedContext) *ruleProbe {
+	return &ruleProbe{provider: p, evalCtx: evalCtx}
+}
+
+// ready builds the probe's client if needed, and reports whether it could be
+// built. Groups set with WithGroupMembership are given to the client.
+func (r *ruleProbe) ready(ctx context.Context) bool {
+	if r.built {
+		return r.client != nil
+	}
+	r.built = true
+
+	p := r.provider
+	features := p.gbClient.Features()
+	r.features = make(gb.FeatureMap, len(features)+1)
+	for key, f := range features {
+		r.features[key] = f
+	}
+	r.attrs = p.buildAttributes(r.evalCtx)
+	var client *gb.Client
+	var err error
+	if p.groupMembership != nil {
+		// Probes never report exposures
+		if client, err = p.groupMembershipClient(ctx, r.attrs); err == nil {
+			client, err = client.WithExperimentCallback(nil)
+		}
+	} else {
+		client, err = gb.NewClient(ctx, gb.WithAttributes(r.attrs), gb.WithLogger(p.log()))
+	}
+	if err != nil {
+		return false
+	}
+	// The client keeps the map, so probed rules are swapped into it in place
+	_ = client.SetFeatures(r.features)
+	r.client = client
+	return true
+}
+
+// eval evaluates rule as the only rule of the flag key and returns the source
+// of the result. The flag's definition is restored afterwards, so later
+// probes see it as a prerequisite. It returns "" if the probe isn't ready.
+func (r *ruleProbe) eval(ctx context.Context, key string, rule gb.FeatureRule) gb.FeatureResultSource {
+	if !r.ready(ctx) {
+		return ""
+	}
+	original, ok := r.features[key]
+	r.features[key] = &gb.Feature{Rules: []gb.FeatureRule{rule}}
+	source := r.client.EvalFeature(ctx, key).Source
+	if ok {
+		r.features[key] = original
+	} else {
+		delete(r.features, key)
+	}
+	return source
+}
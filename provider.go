@@ -4,6 +4,7 @@ package growthbook
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -13,24 +14,49 @@ import (
 
 // Provider implements the OpenFeature provider interface for GrowthBook.
 type Provider struct {
-	gbClient       *gb.Client
-	state          openfeature.State
-	stateMutex     sync.RWMutex
-	timeout        time.Duration // Timeout for feature loading
-	usesDataSource bool          // Whether the client uses a built-in data source
+	config
+	gbClient   *gb.Client
+	state      openfeature.State
+	stateMutex sync.RWMutex
+	listeners  stateListeners // Callbacks registered with OnStateChange
+	lastLoaded time.Time      // Time Init last loaded features successfully
+	events     chan openfeature.Event
+	eventSubs  eventSubscribers // Channels returned by Subscribe
+
+	watchDone chan struct{} // Closed to stop the change event and health log goroutines
+	watchWG   sync.WaitGroup
+
+	attrsMutex sync.RWMutex
+	attributes gb.Attributes // Persistent attributes set with SetAttributes
+	initAttrs  gb.Attributes // Attributes of the Init context, kept with WithInitAttributes
+
+	// Set on clones: the provider the clone was derived from, which owns the
+	// underlying client and readiness, and the attributes the clone carries
+	parent    *Provider
+	baseAttrs gb.Attributes
+	closed    bool
 }
 
 // Metadata returns metadata about the provider.
 func (p *Provider) Metadata() openfeature.Metadata {
+	name := p.providerName
+	if name == "" {
+		name = "GrowthBook Provider"
+	}
+	if p.domain != "" {
+		name += " (" + p.domain + ")"
+	}
 	return openfeature.Metadata{
-		Name: "GrowthBook Provider",
+		Name: name,
 	}
 }
 
 // NewProvider creates a new instance of the GrowthBook OpenFeature provider.
 // You can specify optional parameters:
-//   - timeout: Time to wait for feature loading during initialization (default: 30s)
+//   - timeout: Time to wait for feature loading during initialization (default: 30s),
+//     the same as WithLoadTimeout
 //   - usesDataSource: Whether the client uses a built-in data source that requires loading
+//   - Option: Any of the With* functional options defined in this package
 func NewProvider(gbClient *gb.Client, options ...interface{}) *Provider {
 	if gbClient == nil {
 		// Log warning that a nil client was provided and a default is being created
@@ -41,6 +67,7 @@ func NewProvider(gbClient *gb.Client, options ...interface{}) *Provider {
 	loadTimeout := 30 * time.Second
 	// Default to assuming a data source is used
 	usesDataSource := true
+	var providerOptions []Option
 
 	// Process options
 	for _, option := range options {
@@ -53,15 +80,46 @@ func NewProvider(gbClient *gb.Client, options ...interface{}) *Provider {
 		case bool:
 			// If a bool is provided, use it to set usesDataSource
 			usesDataSource = opt
+		case Option:
+			// Functional options are applied once the defaults are in place
+			providerOptions = append(providerOptions, opt)
 		}
 	}
 
-	return &Provider{
-		gbClient:       gbClient,
-		state:          openfeature.NotReadyState,
-		timeout:        loadTimeout,
-		usesDataSource: usesDataSource,
+	p := &Provider{
+		config: config{
+			loadTimeout:    loadTimeout,
+			usesDataSource: usesDataSource,
+			now:            time.Now,
+			overrides:      newOverrideStore(),
+			defaults:       newDefaultsRegistry(),
+			counts:         newEvaluationCounts(),
+			sinks:          &sinks{},
+		},
+		gbClient: gbClient,
+		state:    openfeature.NotReadyState,
+		events:   make(chan openfeature.Event, eventBufferSize),
+	}
+
+	for _, opt := range providerOptions {
+		opt(p)
+	}
+
+	if p.fetchTracker != nil {
+		p.fetchTracker.setClock(p.now)
+		p.fetchTracker.subscribe(p.updateStaleState)
+	}
+
+	// Derive the data source mode if it wasn't set explicitly
+	if p.dataSourceMode == "" {
+		if p.usesDataSource {
+			p.dataSourceMode = DataSourceUnknown
+		} else {
+			p.dataSourceMode = DataSourceNone
+		}
 	}
+
+	return p
 }
 
 // Hooks returns any hooks the provider wishes to register.
@@ -71,354 +129,505 @@ func (p *Provider) Hooks() []openfeature.Hook {
 
 // Init initializes the provider
 func (p *Provider) Init(evalCtx openfeature.EvaluationContext) error {
-	p.stateMutex.Lock()
-	defer p.stateMutex.Unlock()
+	return p.InitWithContext(context.Background(), evalCtx)
+}
 
+// InitWithContext initializes the provider like Init, but stops waiting for
+// features to load as soon as ctx is done, e.g. when the application shuts
+// down during startup. The context's error is then reported in the returned
+// ProviderInitError.
+func (p *Provider) InitWithContext(ctx context.Context, evalCtx openfeature.EvaluationContext) error {
 	// Set state to not ready initially
-	p.state = openfeature.NotReadyState
+	p.setState(openfeature.NotReadyState)
+
+	// The GrowthBook client is never changed: its attributes are only set on
+	// the child clients created for each evaluation
+	if p.initAttributes {
+		attrs := make(gb.Attributes, len(evalCtx.Attributes()))
+		for k, v := range evalCtx.Attributes() {
+			attrs[k] = v
+		}
+		p.attrsMutex.Lock()
+		p.initAttrs = attrs
+		p.attrsMutex.Unlock()
+	}
+
+	if p.remoteEval && p.remoteEndpoint == nil {
+		p.setState(openfeature.ErrorState)
+		return &openfeature.ProviderInitError{
+			ErrorCode: openfeature.ProviderFatalCode,
+			Message:   "remote evaluation is enabled without an endpoint (see WithRemoteEvalEndpoint)",
+		}
+	}
 
-	// Get attributes from evaluation context
-	attrs := evalCtx.Attributes()
-	if len(attrs) > 0 {
-		//nolint:errcheck
-		p.gbClient.WithAttributes(attrs)
+	// Features from a custom loader bypass the client's data source
+	if p.featureLoader != nil {
+		ctx, cancel := context.WithTimeout(ctx, p.loadTimeout)
+		defer cancel()
+		if err := p.initFeatureLoader(ctx); err != nil {
+			return err
+		}
 	}
 
-	// Only check for feature loading if a data source is being used
-	if p.usesDataSource {
+	// Only check for feature loading if a data source is being used. The state
+	// lock isn't held while waiting, as fetches observed by a fetch tracker
+	// update the stale state.
+	if p.usesDataSource && p.featureLoader == nil {
 		// Create a context with a reasonable timeout for loading features
-		ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+		ctx, cancel := context.WithTimeout(ctx, p.loadTimeout)
 		defer cancel()
 
 		// If the client has a data source, ensure it's loaded
 		if err := p.gbClient.EnsureLoaded(ctx); err != nil {
-			p.state = openfeature.ErrorState
+			p.setState(openfeature.ErrorState)
 			return &openfeature.ProviderInitError{
-				ErrorCode: openfeature.ProviderFatalCode,
+				ErrorCode: initErrorCode(err),
 				Message:   fmt.Sprintf("failed to load GrowthBook features: %v", err),
 			}
 		}
 	}
 
 	// Mark as ready
+	p.stateMutex.Lock()
+	old := p.state
 	p.state = openfeature.ReadyState
+	p.lastLoaded = p.now()
+	p.startWatching()
+	p.stateMutex.Unlock()
+	p.notifyState(old, openfeature.ReadyState)
+	p.eventSubs.publish(openfeature.Event{
+		ProviderName:         p.Metadata().Name,
+		EventType:            openfeature.ProviderReady,
+		ProviderEventDetails: openfeature.ProviderEventDetails{Message: "GrowthBook provider is ready"},
+	})
 	return nil
 }
 
+// setState sets the provider state
+func (p *Provider) setState(state openfeature.State) {
+	p.stateMutex.Lock()
+	old := p.state
+	p.state = state
+	p.stateMutex.Unlock()
+	p.notifyState(old, state)
+}
+
 // Status returns the current provider status
 func (p *Provider) Status() openfeature.State {
 	p.stateMutex.RLock()
 	defer p.stateMutex.RUnlock()
+
+	// A clone is ready whenever its parent is, until it's shut down itself
+	if p.parent != nil {
+		if p.closed {
+			return openfeature.NotReadyState
+		}
+		return p.parent.Status()
+	}
 	return p.state
 }
 
 // Shutdown cleans up any resources used by the provider
 func (p *Provider) Shutdown() {
+	_ = p.ShutdownWithContext(context.Background())
+}
+
+// ShutdownWithContext shuts the provider down like Shutdown, flushing buffered
+// events with Flush first. It stops waiting for them once ctx is done and
+// returns the context's error.
+func (p *Provider) ShutdownWithContext(ctx context.Context) error {
+	err := p.Flush(ctx)
+	p.stopWatching()
+
 	p.stateMutex.Lock()
-	defer p.stateMutex.Unlock()
 
-	// Close the GrowthBook client to clean up resources
+	// Close the GrowthBook client to clean up resources. A clone's client
+	// belongs to its parent and stays open.
+	if p.parent != nil {
+		p.closed = true
+		p.stateMutex.Unlock()
+		return err
+	}
 	p.gbClient.Close()
+	if p.auditLog != nil {
+		if closeErr := p.auditLog.close(ctx); err == nil {
+			err = closeErr
+		}
+	}
 
 	// Set state to not ready on shutdown
+	old := p.state
 	p.state = openfeature.NotReadyState
+	p.stateMutex.Unlock()
+	p.notifyState(old, openfeature.NotReadyState)
+	return err
 }
 
 // BooleanEvaluation evaluates a boolean feature flag.
 func (p *Provider) BooleanEvaluation(ctx context.Context, flag string, defaultValue bool, evalCtx openfeature.FlattenedContext) openfeature.BoolResolutionDetail {
-	// Check if provider is ready
-	if p.Status() != openfeature.ReadyState {
-		return openfeature.BoolResolutionDetail{
-			Value: defaultValue,
-			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
-				ResolutionError: openfeature.NewProviderNotReadyResolutionError("GrowthBook provider is not ready"),
-				Reason:          openfeature.ErrorReason,
-			},
-		}
+	value, detail := resolveTyped(p, ctx, flag, defaultValue, evalCtx, toBool, "a boolean")
+	return openfeature.BoolResolutionDetail{
+		Value:                    value,
+		ProviderResolutionDetail: detail,
 	}
+}
 
-	feature := p.evaluateFlag(ctx, flag, evalCtx)
-
-	// Flag not found
-	if feature == nil || feature.Source == gb.UnknownFeatureResultSource {
-		return openfeature.BoolResolutionDetail{
-			Value: defaultValue,
-			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
-				ResolutionError: openfeature.NewFlagNotFoundResolutionError(fmt.Sprintf("flag '%s' not found", flag)),
-				Reason:          openfeature.ErrorReason,
-			},
-		}
+// StringEvaluation evaluates a string feature flag.
+func (p *Provider) StringEvaluation(ctx context.Context, flag string, defaultValue string, evalCtx openfeature.FlattenedContext) openfeature.StringResolutionDetail {
+	value, detail := resolveTyped(p, ctx, flag, defaultValue, evalCtx, toString, "a string")
+	return openfeature.StringResolutionDetail{
+		Value:                    value,
+		ProviderResolutionDetail: detail,
 	}
+}
 
-	if feature.Value != nil {
-		if value, ok := feature.Value.(bool); ok {
-			return openfeature.BoolResolutionDetail{
-				Value:                    value,
-				ProviderResolutionDetail: createResolutionDetail(feature),
-			}
-		}
+// FloatEvaluation evaluates a float feature flag.
+func (p *Provider) FloatEvaluation(ctx context.Context, flag string, defaultValue float64, evalCtx openfeature.FlattenedContext) openfeature.FloatResolutionDetail {
+	convert := func(v interface{}) (float64, bool) { return toFloat64(v, p.numericStrings) }
+	value, detail := resolveTyped(p, ctx, flag, defaultValue, evalCtx, convert, "a numeric")
+	return openfeature.FloatResolutionDetail{
+		Value:                    value,
+		ProviderResolutionDetail: detail,
+	}
+}
 
-		// Type mismatch
-		return openfeature.BoolResolutionDetail{
-			Value: defaultValue,
-			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
-				ResolutionError: openfeature.NewTypeMismatchResolutionError(
-					fmt.Sprintf("flag '%s' exists but is not a boolean value", flag)),
-				Reason: openfeature.ErrorReason,
-			},
-		}
+// IntEvaluation evaluates an integer feature flag.
+func (p *Provider) IntEvaluation(ctx context.Context, flag string, defaultValue int64, evalCtx openfeature.FlattenedContext) openfeature.IntResolutionDetail {
+	convert := func(v interface{}) (int64, bool) { return toInt64(v, p.numericStrings) }
+	value, detail := resolveTyped(p, ctx, flag, defaultValue, evalCtx, convert, "an integer")
+	return openfeature.IntResolutionDetail{
+		Value:                    value,
+		ProviderResolutionDetail: detail,
 	}
+}
 
-	return openfeature.BoolResolutionDetail{
-		Value:                    defaultValue,
-		ProviderResolutionDetail: createDefaultResolutionDetail(),
+// ObjectEvaluation evaluates an object feature flag.
+func (p *Provider) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, evalCtx openfeature.FlattenedContext) openfeature.InterfaceResolutionDetail {
+	value, detail := resolveTyped(p, ctx, flag, defaultValue, evalCtx, toObject, "an object")
+	return openfeature.InterfaceResolutionDetail{
+		Value:                    value,
+		ProviderResolutionDetail: detail,
 	}
 }
 
-// StringEvaluation evaluates a string feature flag.
-func (p *Provider) StringEvaluation(ctx context.Context, flag string, defaultValue string, evalCtx openfeature.FlattenedContext) openfeature.StringResolutionDetail {
-	// Check if provider is ready
-	if p.Status() != openfeature.ReadyState {
-		return openfeature.StringResolutionDetail{
-			Value: defaultValue,
-			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
-				ResolutionError: openfeature.NewProviderNotReadyResolutionError("GrowthBook provider is not ready"),
-				Reason:          openfeature.ErrorReason,
-			},
+// resolveTyped is the shared body of the typed evaluation methods. It resolves
+// the flag and converts its value with convert, returning the default value
+// if the flag can't be resolved, has no value or can't be converted.
+func resolveTyped[T any](p *Provider, ctx context.Context, flag string, defaultValue T, evalCtx openfeature.FlattenedContext, convert func(interface{}) (T, bool), kind string) (value T, detail openfeature.ProviderResolutionDetail) {
+	start := time.Now()
+	// The cache key and fingerprints are built from the attributes the flag is
+	// evaluated with, so they include those of sources and the resolver
+	evalCtx = p.resolveAttributes(ctx, evalCtx)
+	defer func() { p.observe(flag, value, evalCtx, detail, start) }()
+	defer p.filterMetadata(&detail)
+	defer p.addBaseMetadata(&detail, flag, requestedType[T]())
+	defer markDryRun(ctx, &detail)
+
+	key, cacheable := p.cacheKey(ctx, flag, kind, evalCtx)
+	if cacheable {
+		if cached, ok := p.cache.get(key, p.now(), p.gbClient.Features()); ok {
+			if cachedValue, ok := cached.value.(T); ok {
+				return cachedValue, markEqualsDefault(cachedDetail(cached.detail), cachedValue, defaultValue)
+			}
 		}
 	}
 
-	feature := p.evaluateFlag(ctx, flag, evalCtx)
-
-	// Flag not found
-	if feature == nil || feature.Source == gb.UnknownFeatureResultSource {
-		return openfeature.StringResolutionDetail{
-			Value: defaultValue,
-			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
-				ResolutionError: openfeature.NewFlagNotFoundResolutionError(fmt.Sprintf("flag '%s' not found", flag)),
-				Reason:          openfeature.ErrorReason,
-			},
+	value, detail, fromFlag := resolveTypedValue(p, ctx, flag, defaultValue, evalCtx, convert, kind)
+	if !fromFlag {
+		return value, detail
+	}
+	if cacheable {
+		if p.oversized(value) {
+			p.log().Debug("Skipped caching an oversized object", "flag", flag, "maxObjectSize", p.maxObjectSize)
+		} else {
+			p.cache.put(key, value, detail, p.now(), p.gbClient.Features())
 		}
 	}
+	return value, markEqualsDefault(detail, value, defaultValue)
+}
 
-	if feature.Value != nil {
-		if value, ok := feature.Value.(string); ok {
-			return openfeature.StringResolutionDetail{
-				Value:                    value,
-				ProviderResolutionDetail: createResolutionDetail(feature),
+// resolveTypedValue resolves a typed flag without the evaluation cache.
+// fromFlag reports whether the value came from the flag itself, rather than
+// from the default value or a fallback provider.
+func resolveTypedValue[T any](p *Provider, ctx context.Context, flag string, defaultValue T, evalCtx openfeature.FlattenedContext, convert func(interface{}) (T, bool), kind string) (value T, detail openfeature.ProviderResolutionDetail, fromFlag bool) {
+	feature, errDetail := p.resolveFlag(ctx, flag, evalCtx)
+	if errDetail != nil {
+		if p.delegatesToFallback(*errDetail) {
+			if fallbackValue, fallbackDetail, ok := resolveWithFallback(p, ctx, flag, defaultValue, evalCtx); ok {
+				return fallbackValue, fallbackDetail, false
 			}
 		}
-
-		// Type mismatch
-		return openfeature.StringResolutionDetail{
-			Value: defaultValue,
-			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
-				ResolutionError: openfeature.NewTypeMismatchResolutionError(
-					fmt.Sprintf("flag '%s' exists but is not a string value", flag)),
-				Reason: openfeature.ErrorReason,
-			},
+		if errDetail.ResolutionDetail().ErrorCode == openfeature.FlagNotFoundCode {
+			if registered, ok := registeredDefault[T](p, flag); ok {
+				return registered, registeredDefaultDetail(), false
+			}
 		}
+		return defaultValue, *errDetail, false
+	}
+	raw := derefValue(feature.Value)
+	if raw == nil {
+		return defaultValue, p.valuelessDetail(ctx, flag, feature, p.newRuleProbe(p.withTimeAttribute(ctx, evalCtx))), false
 	}
 
-	return openfeature.StringResolutionDetail{
-		Value:                    defaultValue,
-		ProviderResolutionDetail: createDefaultResolutionDetail(),
+	converted, ok := convert(raw)
+	if !ok {
+		return defaultValue, typeMismatchDetail(flag, kind), false
+	}
+	if p.emptyAsDefault && isEmptyObject(raw) {
+		return defaultValue, p.emptyObjectDetail(feature), false
+	}
+	if p.rejectOversized && p.oversized(raw) {
+		return defaultValue, p.oversizedDetail(flag), false
 	}
+	return applyTransform(p, flag, converted), p.createResolutionDetail(feature), true
 }
 
-// FloatEvaluation evaluates a float feature flag.
-func (p *Provider) FloatEvaluation(ctx context.Context, flag string, defaultValue float64, evalCtx openfeature.FlattenedContext) openfeature.FloatResolutionDetail {
-	// Check if provider is ready
-	if p.Status() != openfeature.ReadyState {
-		return openfeature.FloatResolutionDetail{
-			Value: defaultValue,
-			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
-				ResolutionError: openfeature.NewProviderNotReadyResolutionError("GrowthBook provider is not ready"),
-				Reason:          openfeature.ErrorReason,
-			},
-		}
+// typeMismatchDetail describes a flag whose value isn't of the kind requested
+func typeMismatchDetail(flag string, kind string) openfeature.ProviderResolutionDetail {
+	return openfeature.ProviderResolutionDetail{
+		ResolutionError: openfeature.NewTypeMismatchResolutionError(
+			fmt.Sprintf("flag '%s' exists but is not %s value", flag, kind)),
+		Reason: openfeature.ErrorReason,
 	}
+}
 
-	feature := p.evaluateFlag(ctx, flag, evalCtx)
-
-	// Flag not found
-	if feature == nil || feature.Source == gb.UnknownFeatureResultSource {
-		return openfeature.FloatResolutionDetail{
-			Value: defaultValue,
-			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
-				ResolutionError: openfeature.NewFlagNotFoundResolutionError(fmt.Sprintf("flag '%s' not found", flag)),
-				Reason:          openfeature.ErrorReason,
-			},
-		}
+// resolveFlag checks the provider is ready and evaluates the flag. evalCtx
+// must already be enriched with resolveAttributes. If the flag can't be
+// resolved, the feature is nil and the returned detail describes why the
+// default value is served.
+func (p *Provider) resolveFlag(ctx context.Context, flag string, evalCtx openfeature.FlattenedContext) (*gb.FeatureResult, *openfeature.ProviderResolutionDetail) {
+	if p.evalTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.evalTimeout)
+		defer cancel()
 	}
 
-	if feature.Value != nil {
-		switch v := feature.Value.(type) {
-		case float64:
-			return openfeature.FloatResolutionDetail{
-				Value:                    v,
-				ProviderResolutionDetail: createResolutionDetail(feature),
-			}
-		case float32:
-			return openfeature.FloatResolutionDetail{
-				Value:                    float64(v),
-				ProviderResolutionDetail: createResolutionDetail(feature),
-			}
-		case int:
-			return openfeature.FloatResolutionDetail{
-				Value:                    float64(v),
-				ProviderResolutionDetail: createResolutionDetail(feature),
-			}
-		default:
-			// Type mismatch
-			return openfeature.FloatResolutionDetail{
-				Value: defaultValue,
-				ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
-					ResolutionError: openfeature.NewTypeMismatchResolutionError(
-						fmt.Sprintf("flag '%s' exists but is not a numeric value", flag)),
-					Reason: openfeature.ErrorReason,
-				},
-			}
+	// Check if provider is ready
+	p.updateStaleState()
+	if !p.canEvaluate() {
+		return nil, &openfeature.ProviderResolutionDetail{
+			ResolutionError: openfeature.NewProviderNotReadyResolutionError("GrowthBook provider is not ready"),
+			Reason:          openfeature.ErrorReason,
 		}
 	}
+	if stale := p.checkFresh(ctx, flag); stale != nil {
+		return nil, stale
+	}
 
-	return openfeature.FloatResolutionDetail{
-		Value:                    defaultValue,
-		ProviderResolutionDetail: createDefaultResolutionDetail(),
+	evalCtx = p.withTimeAttribute(ctx, evalCtx)
+	if missing := p.missingRequiredAttributes(evalCtx); len(missing) > 0 {
+		return nil, &openfeature.ProviderResolutionDetail{
+			ResolutionError: openfeature.NewTargetingKeyMissingResolutionError(
+				fmt.Sprintf("flag '%s' evaluated without required attributes: %s", flag, strings.Join(missing, ", "))),
+			Reason: openfeature.ErrorReason,
+		}
 	}
-}
 
-// IntEvaluation evaluates an integer feature flag.
-func (p *Provider) IntEvaluation(ctx context.Context, flag string, defaultValue int64, evalCtx openfeature.FlattenedContext) openfeature.IntResolutionDetail {
-	// Check if provider is ready
-	if p.Status() != openfeature.ReadyState {
-		return openfeature.IntResolutionDetail{
-			Value: defaultValue,
-			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
-				ResolutionError: openfeature.NewProviderNotReadyResolutionError("GrowthBook provider is not ready"),
-				Reason:          openfeature.ErrorReason,
-			},
+	if p.killSwitchFlag != "" && flag != p.killSwitchFlag && p.isKilled(ctx, evalCtx) {
+		return nil, &openfeature.ProviderResolutionDetail{
+			Reason:       openfeature.DisabledReason,
+			FlagMetadata: openfeature.FlagMetadata{"killSwitch": p.killSwitchFlag},
 		}
 	}
 
-	feature := p.evaluateFlag(ctx, flag, evalCtx)
+	if skipped := p.skipWithoutHashAttribute(ctx, flag, evalCtx); skipped != nil {
+		return skipped, nil
+	}
 
-	// Flag not found
-	if feature == nil || feature.Source == gb.UnknownFeatureResultSource {
-		return openfeature.IntResolutionDetail{
-			Value: defaultValue,
-			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
-				ResolutionError: openfeature.NewFlagNotFoundResolutionError(fmt.Sprintf("flag '%s' not found", flag)),
-				Reason:          openfeature.ErrorReason,
-			},
+	feature, err := p.evaluateFlag(ctx, flag, evalCtx)
+	if err != nil {
+		return nil, &openfeature.ProviderResolutionDetail{
+			ResolutionError: openfeature.NewGeneralResolutionError(err.Error()),
+			Reason:          openfeature.ErrorReason,
 		}
 	}
 
-	if feature.Value != nil {
-		switch v := feature.Value.(type) {
-		case int64:
-			return openfeature.IntResolutionDetail{
-				Value:                    v,
-				ProviderResolutionDetail: createResolutionDetail(feature),
-			}
-		case int:
-			return openfeature.IntResolutionDetail{
-				Value:                    int64(v),
-				ProviderResolutionDetail: createResolutionDetail(feature),
-			}
-		case float64:
-			return openfeature.IntResolutionDetail{
-				Value:                    int64(v),
-				ProviderResolutionDetail: createResolutionDetail(feature),
-			}
-		default:
-			// Type mismatch
-			return openfeature.IntResolutionDetail{
-				Value: defaultValue,
-				ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
-					ResolutionError: openfeature.NewTypeMismatchResolutionError(
-						fmt.Sprintf("flag '%s' exists but is not a numeric value", flag)),
-					Reason: openfeature.ErrorReason,
-				},
+	// The flag may be unknown only because a reload is in flight, so give the
+	// data source a moment and try once more
+	if p.evalRetryWait > 0 && p.usesDataSource && isUnknownFeature(feature) && !p.knownFlags[flag] {
+		select {
+		case <-time.After(p.evalRetryWait):
+		case <-ctx.Done():
+		}
+		feature, err = p.evaluateFlag(ctx, flag, evalCtx)
+		if err != nil || isUnknownFeature(feature) {
+			return nil, &openfeature.ProviderResolutionDetail{
+				ResolutionError: openfeature.NewGeneralResolutionError(
+					fmt.Sprintf("flag '%s' is still unknown after retrying evaluation", flag)),
+				Reason: openfeature.ErrorReason,
 			}
 		}
 	}
 
-	return openfeature.IntResolutionDetail{
-		Value:                    defaultValue,
-		ProviderResolutionDetail: createDefaultResolutionDetail(),
+	if isUnknownFeature(feature) {
+		detail := p.unknownFeatureDetail(flag, feature)
+		return nil, &detail
 	}
+
+	return feature, nil
 }
 
-// ObjectEvaluation evaluates an object feature flag.
-func (p *Provider) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, evalCtx openfeature.FlattenedContext) openfeature.InterfaceResolutionDetail {
-	// Check if provider is ready
-	if p.Status() != openfeature.ReadyState {
-		return openfeature.InterfaceResolutionDetail{
-			Value: defaultValue,
-			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
-				ResolutionError: openfeature.NewProviderNotReadyResolutionError("GrowthBook provider is not ready"),
-				Reason:          openfeature.ErrorReason,
-			},
-		}
+// isKilled reports whether the kill switch flag resolves to false, disabling
+// all other flags. A missing kill switch flag doesn't disable anything.
+func (p *Provider) isKilled(ctx context.Context, evalCtx openfeature.FlattenedContext) bool {
+	killSwitch, err := p.evaluateFlag(ctx, p.killSwitchFlag, evalCtx)
+	if err != nil || isUnknownFeature(killSwitch) {
+		return false
 	}
+	enabled, ok := killSwitch.Value.(bool)
+	return ok && !enabled
+}
 
-	feature := p.evaluateFlag(ctx, flag, evalCtx)
+// canEvaluate reports whether the provider is in a state that serves evaluations.
+// Stale features are still served.
+func (p *Provider) canEvaluate() bool {
+	state := p.Status()
+	return state == openfeature.ReadyState || state == openfeature.StaleState
+}
+
+// isUnknownFeature reports whether GrowthBook didn't know the evaluated feature
+func isUnknownFeature(feature *gb.FeatureResult) bool {
+	return feature == nil || feature.Source == gb.UnknownFeatureResultSource
+}
 
-	// Flag not found
-	if feature == nil || feature.Source == gb.UnknownFeatureResultSource {
-		return openfeature.InterfaceResolutionDetail{
-			Value: defaultValue,
-			ProviderResolutionDetail: openfeature.ProviderResolutionDetail{
-				ResolutionError: openfeature.NewFlagNotFoundResolutionError(fmt.Sprintf("flag '%s' not found", flag)),
-				Reason:          openfeature.ErrorReason,
-			},
+// unknownFeatureDetail describes why an unknown feature result was returned.
+// A nil result, or a client that has never held any features, means the client
+// couldn't evaluate the flag rather than that the flag doesn't exist.
+func (p *Provider) unknownFeatureDetail(flag string, feature *gb.FeatureResult) openfeature.ProviderResolutionDetail {
+	if feature == nil || (!p.remoteEval && p.gbClient.Features() == nil) {
+		return openfeature.ProviderResolutionDetail{
+			ResolutionError: openfeature.NewProviderNotReadyResolutionError(
+				fmt.Sprintf("flag '%s' can't be evaluated: the GrowthBook client has no features loaded", flag)),
+			Reason: openfeature.ErrorReason,
 		}
 	}
+	// GrowthBook leaves features disabled in the SDK connection's environment
+	// out of its payload, so a known flag that's missing is turned off
+	if p.knownFlags[flag] {
+		return openfeature.ProviderResolutionDetail{Reason: openfeature.DisabledReason}
+	}
+	return openfeature.ProviderResolutionDetail{
+		ResolutionError: openfeature.NewFlagNotFoundResolutionError(fmt.Sprintf("flag '%s' not found", flag)),
+		Reason:          openfeature.ErrorReason,
+	}
+}
 
-	if feature.Value != nil {
-		return openfeature.InterfaceResolutionDetail{
-			Value:                    feature.Value,
-			ProviderResolutionDetail: createResolutionDetail(feature),
+// evaluateFlag calls GrowthBook's feature evaluation. An error is only
+// returned when the client for the evaluation can't be built, e.g. when remote
+// evaluation fails.
+func (p *Provider) evaluateFlag(ctx context.Context, flag string, evalCtx openfeature.FlattenedContext) (*gb.FeatureResult, error) {
+	if forced := p.forcedResult(flag); forced != nil {
+		return forced, nil
+	}
+	if override := p.overrideResult(flag); override != nil {
+		return override, nil
+	}
+	var client *gb.Client
+	var err error
+	switch {
+	case p.remoteEval:
+		if client, err = p.remoteClient(ctx, p.buildAttributes(evalCtx)); err != nil {
+			return nil, err
+		}
+	case p.groupMembership != nil:
+		if client, err = p.groupMembershipClient(ctx, p.buildAttributes(evalCtx)); err != nil {
+			return nil, err
 		}
+	default:
+		client = p.clientFor(evalCtx)
 	}
+	client = p.withForcedVariations(ctx, p.withDevURL(ctx, client), flag)
 
-	return openfeature.InterfaceResolutionDetail{
-		Value:                    defaultValue,
-		ProviderResolutionDetail: createDefaultResolutionDetail(),
+	// Evaluate the feature in GrowthBook
+	feature := client.EvalFeature(ctx, flag)
+
+	// Experiments skipped for lack of a hash attribute may still bucket the
+	// user through the flag's fallback attribute
+	if feature != nil && feature.Source == gb.DefaultValueResultSource {
+		if fallbackResult := p.evaluateWithFallback(ctx, client, flag, p.buildAttributes(evalCtx)); fallbackResult != nil {
+			return fallbackResult, nil
+		}
 	}
+	return feature, nil
 }
 
-// evaluateFlag calls GrowthBook's feature evaluation
-func (p *Provider) evaluateFlag(ctx context.Context, flag string, evalCtx openfeature.FlattenedContext) *gb.FeatureResult {
-	// Set attributes from evalCtx to GrowthBook
-	attr := make(map[string]interface{})
-
-	// Convert evalCtx to GrowthBook attributes
-	for k, v := range evalCtx {
-		attr[k] = v
+// clientFor returns a child GrowthBook client carrying the attributes of evalCtx
+func (p *Provider) clientFor(evalCtx openfeature.FlattenedContext) *gb.Client {
+	// A clone's client already carries its attributes and tracking callback,
+	// so it's only extended when the evaluation context adds attributes
+	if p.parent != nil {
+		overrides := p.contextAttributes(evalCtx)
+		if len(overrides) == 0 {
+			return p.gbClient
+		}
+		client, _ := p.gbClient.WithAttributeOverrides(overrides)
+		return client
 	}
 
-	client, _ := p.gbClient.WithAttributes(attr)
+	client, _ := p.gbClient.WithAttributes(p.buildAttributes(evalCtx))
+	return p.withTracking(client)
+}
 
-	// Evaluate the feature in GrowthBook
-	return client.EvalFeature(ctx, flag)
+// withTracking routes experiment exposures of client to the tracking callback
+func (p *Provider) withTracking(client *gb.Client) *gb.Client {
+	if p.trackingCallback == nil {
+		return client
+	}
+	client, _ = client.WithExperimentCallback(func(ctx context.Context, exp *gb.Experiment, result *gb.ExperimentResult, _ any) {
+		p.track(ctx, exp, result)
+	})
+	return client
 }
 
 // createResolutionDetail creates a ProviderResolutionDetail from a GrowthBook feature result
-func createResolutionDetail(feature *gb.FeatureResult) openfeature.ProviderResolutionDetail {
+func (p *Provider) createResolutionDetail(feature *gb.FeatureResult) openfeature.ProviderResolutionDetail {
 	reason := openfeature.DefaultReason
-	if feature.Source != "" && feature.Source != gb.UnknownFeatureResultSource && feature.Source != gb.DefaultValueResultSource {
+	if feature.Source != "" && feature.Source != gb.UnknownFeatureResultSource && feature.Source != gb.DefaultValueResultSource && feature.Source != noHashAttributeSource {
 		reason = openfeature.TargetingMatchReason
 	}
+	// Only hashed assignments are pseudorandom: forced variations are targeted
+	if p.splitReason && feature.Source == gb.ExperimentResultSource && !isForcedResult(feature) {
+		reason = openfeature.SplitReason
+	}
 
 	metadata := openfeature.FlagMetadata{
 		"source":     string(feature.Source),
 		"experiment": feature.InExperiment(),
+		// Off is GrowthBook's explicit off state, distinct from a false value
+		"off": feature.Off,
+	}
+	if feature.ExperimentResult != nil && feature.ExperimentResult.HashAttribute != "" {
+		metadata["hashAttribute"] = feature.ExperimentResult.HashAttribute
+	}
+	// Experiments covering only part of the traffic are gradual rollouts
+	if feature.Experiment != nil && feature.Experiment.Coverage != nil {
+		metadata["coverage"] = *feature.Experiment.Coverage
+	}
+	if feature.Source == devOverrideSource {
+		metadata["devOverride"] = true
+	}
+	if feature.Source == overrideSource {
+		metadata["override"] = true
+	}
+	if feature.Source == noHashAttributeSource {
+		metadata["skippedNoHashAttribute"] = true
+	}
+	if isForcedResult(feature) {
+		metadata["forcedVariation"] = true
+	}
+	if p.isStale() {
+		metadata["stale"] = true
+	}
+	if p.preview {
+		metadata["preview"] = true
 	}
 
-	// We'll use RuleId as the variant since GrowthBook doesn't have a direct "variation ID" concept
+	// We'll use RuleId as the variant since GrowthBook doesn't have a direct "variation ID" concept.
+	// Rules without an id that ran an experiment are identified by the variation key.
 	variant := feature.RuleId
+	if variant == "" && feature.ExperimentResult != nil && feature.ExperimentResult.InExperiment {
+		variant = feature.ExperimentResult.Key
+	}
 
 	return openfeature.ProviderResolutionDetail{
 		Reason:       reason,
@@ -427,11 +636,24 @@ func createResolutionDetail(feature *gb.FeatureResult) openfeature.ProviderResol
 	}
 }
 
+// emptyObjectDetail describes an empty object or array served as the default
+// value under WithTreatEmptyObjectAsDefault
+func (p *Provider) emptyObjectDetail(feature *gb.FeatureResult) openfeature.ProviderResolutionDetail {
+	detail := p.createResolutionDetail(feature)
+	detail.Reason = openfeature.DefaultReason
+	detail.FlagMetadata["emptyObject"] = true
+	return detail
+}
+
 // createDefaultResolutionDetail creates a default ProviderResolutionDetail
-func createDefaultResolutionDetail() openfeature.ProviderResolutionDetail {
-	return openfeature.ProviderResolutionDetail{
+func (p *Provider) createDefaultResolutionDetail() openfeature.ProviderResolutionDetail {
+	detail := openfeature.ProviderResolutionDetail{
 		Reason: openfeature.DefaultReason,
 	}
+	if p.isStale() {
+		detail.FlagMetadata = openfeature.FlagMetadata{"stale": true}
+	}
+	return detail
 }
 
 // GetClient returns the underlying GrowthBook client
@@ -3,6 +3,8 @@ package growthbook
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -10,7 +12,7 @@ import (
 	"github.com/open-feature/go-sdk/openfeature"
 )
 
-func setupTestProvider() *Provider {
+func setupTestProvider(options ...interface{}) *Provider {
 	// Create a test client with JSON features in the correct format
 	featuresJSON := `{
 		"bool-flag": {
@@ -54,7 +56,7 @@ func setupTestProvider() *Provider {
 
 	// Create provider with a short timeout and specifying false for usesDataSource
 	// Since we're using in-memory features, we don't need to wait for data source loading
-	provider := NewProvider(gbClient, 5*time.Second, false)
+	provider := NewProvider(gbClient, append([]interface{}{5 * time.Second, false}, options...)...)
 
 	return provider
 }
@@ -146,6 +148,14 @@ func TestMetadata(t *testing.T) {
 	}
 }
 
+func TestMetadataCustomName(t *testing.T) {
+	provider := setupTestProvider(WithProviderName("GrowthBook (billing)"))
+
+	if name := provider.Metadata().Name; name != "GrowthBook (billing)" {
+		t.Errorf("Expected provider name 'GrowthBook (billing)', got %s", name)
+	}
+}
+
 func TestShutdown(t *testing.T) {
 	provider := setupTestProvider()
 
@@ -185,7 +195,7 @@ func TestEvaluateFlag(t *testing.T) {
 	fmt.Printf("DEBUG: Direct GrowthBook evaluation for bool-flag: %+v\n", directResult)
 
 	// Test our evaluateFlag method
-	feature := provider.evaluateFlag(context.Background(), "bool-flag", flattenedCtx)
+	feature, _ := provider.evaluateFlag(context.Background(), "bool-flag", flattenedCtx)
 	fmt.Printf("DEBUG: evaluateFlag result for bool-flag: %+v\n", feature)
 
 	if feature == nil {
@@ -227,7 +237,7 @@ func TestEvaluateFlagWithRule(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			directResult := provider.evaluateFlag(context.Background(), "rules-test", tt.evaluationContext)
+			directResult, _ := provider.evaluateFlag(context.Background(), "rules-test", tt.evaluationContext)
 
 			if tt.expectedResult != directResult.On {
 				t.Errorf("evaluateFlag returned %v, expected %v", directResult.On, tt.expectedResult)
@@ -249,3 +259,227 @@ func TestEvaluateNonExistingFlag(t *testing.T) {
 		t.Error("expected resolution error to be equal to FLAG_NOT_FOUND: flag 'non-existent-flag' not found")
 	}
 }
+
+func TestEvaluateWithoutLoadedFeaturesReturnsNotReady(t *testing.T) {
+	// The client never received any features, so it reports every flag as
+	// unknown even though the provider is ready
+	gbClient, _ := gb.NewClient(context.Background())
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.BooleanEvaluation(context.Background(), "bool-flag", true, nil)
+	if result.Value != true {
+		t.Errorf("Expected the default value, got %v", result.Value)
+	}
+	if got := result.ResolutionDetail().ErrorCode; got != openfeature.ProviderNotReadyCode {
+		t.Errorf("Expected %s error code, got %s", openfeature.ProviderNotReadyCode, got)
+	}
+
+	// Once features are loaded, a missing key is reported as not found
+	_ = gbClient.SetJSONFeatures(`{"other-flag": {"defaultValue": true}}`)
+	result = provider.BooleanEvaluation(context.Background(), "bool-flag", true, nil)
+	if got := result.ResolutionDetail().ErrorCode; got != openfeature.FlagNotFoundCode {
+		t.Errorf("Expected %s error code, got %s", openfeature.FlagNotFoundCode, got)
+	}
+}
+
+func TestKnownFlagMissingFromPayloadIsDisabled(t *testing.T) {
+	// GrowthBook omits "checkout-v2" because it's turned off in this environment
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{"other-flag": {"defaultValue": true}}`))
+	provider := NewProvider(gbClient, false, WithKnownFlags([]string{"checkout-v2", "other-flag"}))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.BooleanEvaluation(context.Background(), "checkout-v2", true, nil)
+	if result.Value != true {
+		t.Errorf("Expected the default value, got %v", result.Value)
+	}
+	if result.Reason != openfeature.DisabledReason {
+		t.Errorf("Expected the %s reason, got %s", openfeature.DisabledReason, result.Reason)
+	}
+	if got := result.ResolutionDetail().ErrorCode; got != "" {
+		t.Errorf("Expected no error code for a disabled flag, got %s", got)
+	}
+
+	// Flags that aren't known are still reported as not found
+	result = provider.BooleanEvaluation(context.Background(), "typo-flag", true, nil)
+	if got := result.ResolutionDetail().ErrorCode; got != openfeature.FlagNotFoundCode {
+		t.Errorf("Expected %s error code for an unknown flag, got %s", openfeature.FlagNotFoundCode, got)
+	}
+	if result := provider.BooleanEvaluation(context.Background(), "other-flag", false, nil); !result.Value {
+		t.Error("Expected an enabled known flag to be evaluated")
+	}
+}
+
+func TestUnknownFeatureDetailForNilResult(t *testing.T) {
+	provider := setupTestProvider()
+
+	detail := provider.unknownFeatureDetail("bool-flag", nil)
+	if got := detail.ResolutionDetail().ErrorCode; got != openfeature.ProviderNotReadyCode {
+		t.Errorf("Expected %s error code for a nil result, got %s", openfeature.ProviderNotReadyCode, got)
+	}
+}
+
+func setupDataSourceProvider(t *testing.T, featuresJSON string, options ...interface{}) (*Provider, *gb.Client) {
+	t.Helper()
+	server := newTestFeatureServer(t, featuresJSON)
+
+	gbClient, err := gb.NewClient(
+		context.Background(),
+		gb.WithApiHost(server.URL),
+		gb.WithClientKey("sdk-test"),
+		gb.WithPollDataSource(time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create GrowthBook client: %v", err)
+	}
+
+	provider := NewProvider(gbClient, append([]interface{}{5 * time.Second}, options...)...)
+	if err := provider.Init(openfeature.NewEvaluationContext("test-user", nil)); err != nil {
+		t.Fatalf("Provider initialization failed: %v", err)
+	}
+	t.Cleanup(provider.Shutdown)
+
+	return provider, gbClient
+}
+
+func TestEvalRetryResolvesUnknownFlag(t *testing.T) {
+	provider, gbClient := setupDataSourceProvider(t, `{}`, WithEvalRetry(200*time.Millisecond))
+
+	// Simulate a reload that lands while the first evaluation is in flight
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		_ = gbClient.SetJSONFeatures(`{"late-flag": {"defaultValue": true}}`)
+	}()
+
+	result := provider.BooleanEvaluation(context.Background(), "late-flag", false, nil)
+	if code := result.ResolutionDetail().ErrorCode; code != "" {
+		t.Fatalf("Expected no resolution error, got %s", code)
+	}
+	if !result.Value {
+		t.Error("Expected retried evaluation to return the reloaded value")
+	}
+}
+
+func TestEvalRetryStillUnknownReturnsGeneralError(t *testing.T) {
+	provider, _ := setupDataSourceProvider(t, `{}`, WithEvalRetry(time.Millisecond))
+
+	result := provider.BooleanEvaluation(context.Background(), "missing-flag", true, nil)
+	if !result.Value {
+		t.Error("Expected default value for missing flag")
+	}
+	if result.Reason != openfeature.ErrorReason {
+		t.Errorf("Expected error reason, got %v", result.Reason)
+	}
+	if got := result.ResolutionDetail().ErrorCode; got != openfeature.GeneralCode {
+		t.Errorf("Expected %s error code, got %s", openfeature.GeneralCode, got)
+	}
+}
+
+func TestEvalRetryDisabledReturnsFlagNotFound(t *testing.T) {
+	provider, _ := setupDataSourceProvider(t, `{}`)
+
+	result := provider.BooleanEvaluation(context.Background(), "missing-flag", false, nil)
+	if got := result.ResolutionDetail().ErrorCode; got != openfeature.FlagNotFoundCode {
+		t.Errorf("Expected %s error code, got %s", openfeature.FlagNotFoundCode, got)
+	}
+}
+
+func TestLoadTimeoutAppliesToInit(t *testing.T) {
+	// A features endpoint that never answers
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	// The data source never finishes starting, so Shutdown can't stop it.
+	// Cancelling its context aborts the pending request before the server closes.
+	dsCtx, cancelDataSource := context.WithCancel(context.Background())
+	defer cancelDataSource()
+
+	gbClient, _ := gb.NewClient(
+		dsCtx,
+		gb.WithApiHost(server.URL),
+		gb.WithClientKey("sdk-test"),
+		gb.WithPollDataSource(time.Hour),
+	)
+	provider := NewProvider(gbClient, WithLoadTimeout(50*time.Millisecond), WithEvaluationTimeout(10*time.Second))
+	defer provider.Shutdown()
+
+	start := time.Now()
+	err := provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+	if err == nil {
+		t.Fatal("Expected init to fail when features don't load in time")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Expected init to give up after the load timeout, took %v", elapsed)
+	}
+}
+
+func TestEvaluationTimeoutAppliesToEvaluation(t *testing.T) {
+	featuresJSON := `{
+		"exp-flag": {
+			"defaultValue": "control",
+			"rules": [{"variations": ["control", "treatment"]}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+
+	var deadline time.Time
+	var hasDeadline bool
+	provider := NewProvider(gbClient, false,
+		WithLoadTimeout(time.Minute),
+		WithEvaluationTimeout(200*time.Millisecond),
+		WithTrackingCallback(func(ctx context.Context, exp *gb.Experiment, result *gb.ExperimentResult) {
+			deadline, hasDeadline = ctx.Deadline()
+		}),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	start := time.Now()
+	provider.StringEvaluation(context.Background(), "exp-flag", "none", openfeature.FlattenedContext{"id": "user-1"})
+
+	if !hasDeadline {
+		t.Fatal("Expected evaluation context to carry a deadline")
+	}
+	if remaining := deadline.Sub(start); remaining > time.Second {
+		t.Errorf("Expected evaluation deadline from the evaluation timeout, got %v", remaining)
+	}
+}
+
+func TestKillSwitchFlag(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"master-switch": {"defaultValue": true},
+		"new-checkout": {"defaultValue": true}
+	}`))
+	provider := NewProvider(gbClient, false, WithKillSwitchFlag("master-switch"))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.BooleanEvaluation(context.Background(), "new-checkout", false, nil)
+	if !result.Value {
+		t.Error("Expected flags to evaluate while the kill switch is on")
+	}
+
+	_ = gbClient.SetJSONFeatures(`{
+		"master-switch": {"defaultValue": false},
+		"new-checkout": {"defaultValue": true}
+	}`)
+	result = provider.BooleanEvaluation(context.Background(), "new-checkout", false, nil)
+	if result.Value {
+		t.Error("Expected the caller's default while the kill switch is off")
+	}
+	if result.Reason != openfeature.DisabledReason {
+		t.Errorf("Expected reason %s, got %s", openfeature.DisabledReason, result.Reason)
+	}
+	if result.FlagMetadata["killSwitch"] != "master-switch" {
+		t.Errorf("Expected killSwitch metadata, got %v", result.FlagMetadata)
+	}
+	if result.ResolutionDetail().ErrorCode != "" {
+		t.Errorf("Expected no error for a disabled flag, got %s", result.ResolutionDetail().ErrorCode)
+	}
+
+	// The kill switch itself still evaluates
+	result = provider.BooleanEvaluation(context.Background(), "master-switch", true, nil)
+	if result.Value || result.Reason == openfeature.DisabledReason {
+		t.Errorf("Expected the kill switch flag to evaluate normally, got %v (%s)", result.Value, result.Reason)
+	}
+}
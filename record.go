@@ -0,0 +1,66 @@
+package growthbook
+
+import (
+	"encoding/json"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// ResolutionRecord is the outcome of an evaluation in a form that can be
+// serialized for audit logs or exported, including flag metadata values that
+// aren't JSON values.
+type ResolutionRecord struct {
+	Flag      string
+	Value     interface{}
+	Reason    openfeature.Reason
+	Variant   string
+	ErrorCode openfeature.ErrorCode
+	Metadata  openfeature.FlagMetadata
+}
+
+// NewResolutionRecord records the evaluation of flag that resolved to value
+// with detail
+func NewResolutionRecord(flag string, value interface{}, detail openfeature.ProviderResolutionDetail) ResolutionRecord {
+	return ResolutionRecord{
+		Flag:      flag,
+		Value:     value,
+		Reason:    detail.Reason,
+		Variant:   detail.Variant,
+		ErrorCode: detail.ResolutionDetail().ErrorCode,
+		Metadata:  detail.FlagMetadata,
+	}
+}
+
+// MarshalJSON implements json.Marshaler. Metadata entries that can't be
+// encoded, such as functions or channels, are skipped, and so is a value that
+// can't be encoded, rather than failing the whole record.
+func (r ResolutionRecord) MarshalJSON() ([]byte, error) {
+	out := struct {
+		Flag      string                     `json:"flag"`
+		Value     json.RawMessage            `json:"value,omitempty"`
+		Reason    openfeature.Reason         `json:"reason,omitempty"`
+		Variant   string                     `json:"variant,omitempty"`
+		ErrorCode openfeature.ErrorCode      `json:"errorCode,omitempty"`
+		Metadata  map[string]json.RawMessage `json:"metadata,omitempty"`
+	}{
+		Flag:      r.Flag,
+		Reason:    r.Reason,
+		Variant:   r.Variant,
+		ErrorCode: r.ErrorCode,
+	}
+
+	if raw, err := json.Marshal(r.Value); err == nil {
+		out.Value = raw
+	}
+	for key, value := range r.Metadata {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+		if out.Metadata == nil {
+			out.Metadata = make(map[string]json.RawMessage, len(r.Metadata))
+		}
+		out.Metadata[key] = raw
+	}
+	return json.Marshal(out)
+}
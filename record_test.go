@@ -0,0 +1,81 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestResolutionRecordMarshalsAllResultTypes(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	ctx := context.Background()
+
+	boolResult := provider.BooleanEvaluation(ctx, "bool-flag", false, nil)
+	stringResult := provider.StringEvaluation(ctx, "string-flag", "", nil)
+	floatResult := provider.FloatEvaluation(ctx, "number-flag", 0, nil)
+	intResult := provider.IntEvaluation(ctx, "int-flag", 0, nil)
+	objectResult := provider.ObjectEvaluation(ctx, "object-flag", nil, nil)
+	missingResult := provider.BooleanEvaluation(ctx, "missing-flag", false, nil)
+
+	records := []ResolutionRecord{
+		NewResolutionRecord("bool-flag", boolResult.Value, boolResult.ProviderResolutionDetail),
+		NewResolutionRecord("string-flag", stringResult.Value, stringResult.ProviderResolutionDetail),
+		NewResolutionRecord("number-flag", floatResult.Value, floatResult.ProviderResolutionDetail),
+		NewResolutionRecord("int-flag", intResult.Value, intResult.ProviderResolutionDetail),
+		NewResolutionRecord("object-flag", objectResult.Value, objectResult.ProviderResolutionDetail),
+		NewResolutionRecord("missing-flag", missingResult.Value, missingResult.ProviderResolutionDetail),
+	}
+	for _, record := range records {
+		raw, err := json.Marshal(record)
+		if err != nil {
+			t.Errorf("Expected %s to marshal, got error: %v", record.Flag, err)
+			continue
+		}
+		var decoded map[string]interface{}
+		if err := json.Unmarshal(raw, &decoded); err != nil {
+			t.Errorf("Expected valid JSON for %s, got %s", record.Flag, raw)
+			continue
+		}
+		if decoded["flag"] != record.Flag {
+			t.Errorf("Expected flag %s, got %v", record.Flag, decoded["flag"])
+		}
+	}
+
+	raw, _ := json.Marshal(records[5])
+	var missing map[string]interface{}
+	_ = json.Unmarshal(raw, &missing)
+	if missing["errorCode"] != string(openfeature.FlagNotFoundCode) {
+		t.Errorf("Expected the FLAG_NOT_FOUND error code, got %s", raw)
+	}
+}
+
+func TestResolutionRecordSkipsUnserializableValues(t *testing.T) {
+	record := ResolutionRecord{
+		Flag:  "flag",
+		Value: func() {},
+		Metadata: openfeature.FlagMetadata{
+			"source":   "force",
+			"callback": func() {},
+			"channel":  make(chan int),
+		},
+	}
+
+	raw, err := json.Marshal(record)
+	if err != nil {
+		t.Fatalf("Expected the record to marshal, got error: %v", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("Expected valid JSON, got %s", raw)
+	}
+	if _, ok := decoded["value"]; ok {
+		t.Errorf("Expected the unserializable value to be skipped, got %s", raw)
+	}
+	metadata, _ := decoded["metadata"].(map[string]interface{})
+	if len(metadata) != 1 || metadata["source"] != "force" {
+		t.Errorf("Expected only the serializable metadata, got %s", raw)
+	}
+}
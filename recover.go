@@ -0,0 +1,30 @@
+package growthbook
+
+import (
+	"context"
+
+	gb "github.com/growthbook/growthbook-golang"
+)
+
+// safely runs fn, a call into user-supplied code, recovering from a panic in
+// it so a buggy callback can't take down the evaluation. The panic is logged
+// with what was being called and reported as false.
+func (p *Provider) safely(what string, fn func()) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			p.log().Error("Recovered from panic in "+what, "panic", r)
+		}
+	}()
+	fn()
+	return true
+}
+
+// track reports an exposure to the tracking callback, if one is set and the
+// evaluation wasn't made without tracking
+func (p *Provider) track(ctx context.Context, exp *gb.Experiment, result *gb.ExperimentResult) {
+	if p.trackingCallback == nil || ctx.Value(untrackedKey{}) != nil {
+		return
+	}
+	ctx = p.withDomain(ctx)
+	p.safely("tracking callback", func() { p.trackingCallback(ctx, exp, result) })
+}
@@ -0,0 +1,63 @@
+package growthbook
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+type panickingMetrics struct{}
+
+func (panickingMetrics) CountEvaluation(string, openfeature.Reason) { panic("metrics down") }
+
+func (panickingMetrics) CountDefaultServed(string, openfeature.Reason, openfeature.ErrorCode) {}
+
+func TestPanickingTrackingCallbackIsRecovered(t *testing.T) {
+	var logs bytes.Buffer
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"exp-flag": {"defaultValue": "none", "rules": [{"key": "exp", "variations": ["control", "treatment"], "coverage": 1}]}
+	}`))
+	provider := NewProvider(gbClient, false,
+		WithLogger(slog.New(slog.NewTextHandler(&logs, nil))),
+		WithTrackingCallback(func(context.Context, *gb.Experiment, *gb.ExperimentResult) {
+			panic("analytics down")
+		}),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.StringEvaluation(context.Background(), "exp-flag", "default", openfeature.FlattenedContext{"id": "user-1"})
+	if result.Value != "control" && result.Value != "treatment" {
+		t.Errorf("Expected a variation despite the panic, got %q", result.Value)
+	}
+	if result.Error() != nil || result.Reason != openfeature.TargetingMatchReason {
+		t.Errorf("Expected a valid result, got %s (%v)", result.Reason, result.Error())
+	}
+	if !strings.Contains(logs.String(), "Recovered from panic in tracking callback") || !strings.Contains(logs.String(), "analytics down") {
+		t.Errorf("Expected the panic to be logged, got %q", logs.String())
+	}
+}
+
+func TestPanickingTransformAndMetricsAreRecovered(t *testing.T) {
+	var logs bytes.Buffer
+	provider := setupTestProvider(
+		WithLogger(slog.New(slog.NewTextHandler(&logs, nil))),
+		WithValueTransform("int-flag", func(interface{}) interface{} { panic("bad transform") }),
+		WithMetrics(panickingMetrics{}),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.IntEvaluation(context.Background(), "int-flag", 0, nil)
+	if result.Value != 42 || result.Error() != nil {
+		t.Errorf("Expected the untransformed value 42, got %d (%v)", result.Value, result.Error())
+	}
+	for _, message := range []string{"bad transform", "metrics down"} {
+		if !strings.Contains(logs.String(), message) {
+			t.Errorf("Expected the %q panic to be logged, got %q", message, logs.String())
+		}
+	}
+}
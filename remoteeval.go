@@ -0,0 +1,79 @@
+package growthbook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	gb "github.com/growthbook/growthbook-golang"
+)
+
+// remoteEvalEndpoint is where remote evaluation requests are posted
+type remoteEvalEndpoint struct {
+	apiHost    string
+	clientKey  string
+	httpClient *http.Client
+}
+
+// remoteEvalRequest is the body posted to GrowthBook's remote evaluation
+// endpoint
+type remoteEvalRequest struct {
+	Attributes       gb.Attributes    `json:"attributes"`
+	ForcedVariations map[string]int   `json:"forcedVariations"`
+	ForcedFeatures   [][2]interface{} `json:"forcedFeatures"`
+	URL              string           `json:"url"`
+}
+
+// remoteClient posts attrs to the remote evaluation endpoint and returns a
+// client holding the features evaluated by GrowthBook for them
+func (p *Provider) remoteClient(ctx context.Context, attrs gb.Attributes) (*gb.Client, error) {
+	if p.remoteEndpoint == nil {
+		return nil, fmt.Errorf("remote evaluation is enabled without an endpoint (see WithRemoteEvalEndpoint)")
+	}
+
+	forced := p.forcedVariations
+	if forced == nil {
+		forced = map[string]int{}
+	}
+	body, err := json.Marshal(remoteEvalRequest{
+		Attributes:       attrs,
+		ForcedVariations: forced,
+		ForcedFeatures:   [][2]interface{}{},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode remote evaluation request: %w", err)
+	}
+
+	url := strings.TrimRight(p.remoteEndpoint.apiHost, "/") + "/api/eval/" + p.remoteEndpoint.clientKey
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create remote evaluation request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.remoteEndpoint.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("remote evaluation request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("remote evaluation request failed with status %d", resp.StatusCode)
+	}
+	respJSON, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read remote evaluation response: %w", err)
+	}
+
+	client, err := gb.NewClient(ctx, gb.WithAttributes(attrs), gb.WithLogger(p.log()))
+	if err != nil {
+		return nil, err
+	}
+	if err := client.UpdateFromApiResponseJSON(string(respJSON)); err != nil {
+		return nil, fmt.Errorf("failed to decode remote evaluation response: %w", err)
+	}
+	return p.withTracking(client), nil
+}
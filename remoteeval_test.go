@@ -0,0 +1,82 @@
+package growthbook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestRemoteEvalPostsAttributes(t *testing.T) {
+	var posted remoteEvalRequest
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&posted); err != nil {
+			t.Errorf("Expected a JSON body, got error: %v", err)
+		}
+		// Remote evaluation answers with features already evaluated for the posted attributes
+		_, _ = w.Write([]byte(`{"features": {"banner-text": {"defaultValue": "evaluated remotely"}}}`))
+	}))
+	defer server.Close()
+
+	// The local features would give a different value
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{"banner-text": {"defaultValue": "evaluated locally"}}`))
+	provider := NewProvider(gbClient, false,
+		WithRemoteEval(true),
+		WithRemoteEvalEndpoint(server.URL, "sdk-abc", server.Client()),
+	)
+	if err := provider.Init(openfeature.NewEvaluationContext("", nil)); err != nil {
+		t.Fatalf("Expected Init to succeed, got %v", err)
+	}
+
+	evalCtx := openfeature.FlattenedContext{"targetingKey": "user-123", "country": "US"}
+	result := provider.StringEvaluation(context.Background(), "banner-text", "default", evalCtx)
+	if result.Value != "evaluated remotely" {
+		t.Errorf("Expected the remotely evaluated value, got %q (error: %v)", result.Value, result.Error())
+	}
+	if path != "/api/eval/sdk-abc" {
+		t.Errorf("Expected a request to /api/eval/sdk-abc, got %q", path)
+	}
+	if posted.Attributes["targetingKey"] != "user-123" || posted.Attributes["country"] != "US" {
+		t.Errorf("Expected the evaluation attributes to be posted, got %v", posted.Attributes)
+	}
+}
+
+func TestRemoteEvalFailureReturnsGeneralError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	gbClient, _ := gb.NewClient(context.Background())
+	provider := NewProvider(gbClient, false,
+		WithRemoteEval(true),
+		WithRemoteEvalEndpoint(server.URL, "sdk-abc", server.Client()),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.BooleanEvaluation(context.Background(), "bool-flag", true, nil)
+	if result.Value != true {
+		t.Errorf("Expected the default value, got %v", result.Value)
+	}
+	if result.ResolutionDetail().ErrorCode != openfeature.GeneralCode {
+		t.Errorf("Expected a General error, got %v", result.ResolutionDetail().ErrorCode)
+	}
+}
+
+func TestRemoteEvalRequiresEndpoint(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background())
+	provider := NewProvider(gbClient, false, WithRemoteEval(true))
+
+	if err := provider.Init(openfeature.NewEvaluationContext("", nil)); err == nil {
+		t.Error("Expected Init to fail without a remote evaluation endpoint")
+	}
+	if provider.Status() != openfeature.ErrorState {
+		t.Errorf("Expected ErrorState, got %v", provider.Status())
+	}
+}
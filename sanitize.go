@@ -0,0 +1,110 @@
+package growthbook
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+)
+
+// sanitizeAttributes returns attrs with every value converted to a type
+// GrowthBook can match against. GrowthBook silently treats unsupported values
+// as null, so values implementing encoding.TextMarshaler or fmt.Stringer are
+// converted to strings, pointers are dereferenced and anything else that can't
+// be represented (funcs, channels, plain structs, ...) is dropped with a
+// warning. attrs is returned unchanged if all its values are supported.
+func (p *Provider) sanitizeAttributes(attrs map[string]interface{}) map[string]interface{} {
+	var sanitized map[string]interface{}
+	for key, value := range attrs {
+		if !needsConversion(value) {
+			continue
+		}
+		converted, ok := sanitizeValue(value)
+		if sanitized == nil {
+			sanitized = make(map[string]interface{}, len(attrs))
+			for k, v := range attrs {
+				sanitized[k] = v
+			}
+		}
+		if !ok {
+			p.log().Warn("Dropping attribute GrowthBook can't evaluate",
+				"attribute", key, "type", fmt.Sprintf("%T", value))
+			delete(sanitized, key)
+			continue
+		}
+		sanitized[key] = converted
+	}
+	if sanitized == nil {
+		return attrs
+	}
+	return sanitized
+}
+
+// needsConversion reports whether value isn't already in a form GrowthBook
+// evaluates as intended
+func needsConversion(value interface{}) bool {
+	switch value.(type) {
+	case nil, bool, string, float64, float32, int, int64, int32, int16, int8,
+		uint, uint64, uint32, uint16, uint8:
+		return false
+	}
+	return true
+}
+
+// sanitizeValue converts value to a type GrowthBook supports. ok is false if
+// value can't be represented.
+func sanitizeValue(value interface{}) (interface{}, bool) {
+	if !needsConversion(value) {
+		return value, true
+	}
+	switch v := value.(type) {
+	case encoding.TextMarshaler:
+		text, err := v.MarshalText()
+		if err != nil {
+			return nil, false
+		}
+		return string(text), true
+	case fmt.Stringer:
+		return v.String(), true
+	}
+
+	ref := reflect.ValueOf(value)
+	switch ref.Kind() {
+	case reflect.Bool:
+		return ref.Bool(), true
+	case reflect.String:
+		return ref.String(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return ref.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return ref.Uint(), true
+	case reflect.Float32, reflect.Float64:
+		return ref.Float(), true
+	case reflect.Ptr, reflect.Interface:
+		if ref.IsNil() {
+			return nil, true
+		}
+		return sanitizeValue(ref.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		items := make([]interface{}, 0, ref.Len())
+		for i := 0; i < ref.Len(); i++ {
+			// Unsupported elements are dropped rather than the whole list
+			if item, ok := sanitizeValue(ref.Index(i).Interface()); ok {
+				items = append(items, item)
+			}
+		}
+		return items, true
+	case reflect.Map:
+		if ref.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+		obj := make(map[string]interface{}, ref.Len())
+		iter := ref.MapRange()
+		for iter.Next() {
+			if item, ok := sanitizeValue(iter.Value().Interface()); ok {
+				obj[iter.Key().String()] = item
+			}
+		}
+		return obj, true
+	}
+	return nil, false
+}
@@ -0,0 +1,59 @@
+package growthbook
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestUnsupportedAttributeIsDropped(t *testing.T) {
+	var logs bytes.Buffer
+	provider := setupTestProvider(WithLogger(slog.New(slog.NewTextHandler(&logs, nil))))
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	evalCtx := openfeature.FlattenedContext{
+		"email":    "user@growthbook.com",
+		"callback": func() {},
+		"updates":  make(chan int),
+	}
+	result := provider.BooleanEvaluation(context.Background(), "rules-test", false, evalCtx)
+	if !result.Value || result.ResolutionDetail().ErrorCode != "" {
+		t.Errorf("Expected the remaining attributes to match the rule, got %v (%s)", result.Value, result.ResolutionDetail().ErrorCode)
+	}
+	if !strings.Contains(logs.String(), "attribute=callback") || !strings.Contains(logs.String(), "attribute=updates") {
+		t.Errorf("Expected a warning for each dropped attribute, got %q", logs.String())
+	}
+}
+
+type plan struct{ name string }
+
+func (p plan) String() string { return p.name }
+
+func TestAttributesAreConverted(t *testing.T) {
+	featuresJSON := `{
+		"converted": {
+			"defaultValue": false,
+			"rules": [{"condition": {"plan": "pro", "seats": 5, "since": "2024-01-02T00:00:00Z"}, "force": true}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	seats := 5
+	evalCtx := openfeature.FlattenedContext{
+		"plan":  plan{"pro"},
+		"seats": &seats,
+		"since": time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	result := provider.BooleanEvaluation(context.Background(), "converted", false, evalCtx)
+	if !result.Value {
+		t.Error("Expected Stringers, pointers and times to be converted for matching")
+	}
+}
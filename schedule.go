@@ -0,0 +1,44 @@
+package growthbook
+
+import (
+	"context"
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// evaluationTimeKey is the context key of the time set by EvaluateAt
+type evaluationTimeKey struct{}
+
+// EvaluateAt evaluates flag as an object as if the current time were at, to
+// preview time-based targeting. GrowthBook applies scheduled rules on its
+// servers, so the SDK payload has no schedules of its own: time-based rules
+// are written as conditions on the time attribute set with WithTimeAttribute,
+// which EvaluateAt sets to at instead of the clock's time. Results aren't
+// cached, and an explicit time attribute in evalCtx still takes precedence.
+func (p *Provider) EvaluateAt(at time.Time, ctx context.Context, flag string, evalCtx openfeature.FlattenedContext) openfeature.InterfaceResolutionDetail {
+	return p.ObjectEvaluation(context.WithValue(ctx, evaluationTimeKey{}, at), flag, nil, evalCtx)
+}
+
+// withTimeAttribute adds the evaluation time to evalCtx under the time
+// attribute, if one is set and evalCtx doesn't have it already. The time is
+// the one given to EvaluateAt, or else the clock's. evalCtx isn't modified.
+func (p *Provider) withTimeAttribute(ctx context.Context, evalCtx openfeature.FlattenedContext) openfeature.FlattenedContext {
+	if p.timeAttr == "" {
+		return evalCtx
+	}
+	if _, ok := evalCtx[p.timeAttr]; ok {
+		return evalCtx
+	}
+	now, ok := ctx.Value(evaluationTimeKey{}).(time.Time)
+	if !ok {
+		now = p.now()
+	}
+
+	withTime := make(openfeature.FlattenedContext, len(evalCtx)+1)
+	for k, v := range evalCtx {
+		withTime[k] = v
+	}
+	withTime[p.timeAttr] = now.UTC().Format(time.RFC3339)
+	return withTime
+}
@@ -0,0 +1,59 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func setupScheduleProvider(t *testing.T, opts ...interface{}) *Provider {
+	t.Helper()
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"summer-sale": {"defaultValue": false, "rules": [{"condition": {"now": {"$gte": "2030-06-01T00:00:00Z"}}, "force": true}]}
+	}`))
+	provider := NewProvider(gbClient, append([]interface{}{false, WithTimeAttribute("now")}, opts...)...)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	return provider
+}
+
+func TestEvaluateAtPreviewsScheduledRule(t *testing.T) {
+	provider := setupScheduleProvider(t, WithEvaluationCache(time.Hour))
+	scheduled := time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	before := provider.EvaluateAt(scheduled.Add(-time.Minute), context.Background(), "summer-sale", nil)
+	if before.Value != false {
+		t.Errorf("Expected the sale to be off before the scheduled time, got %v", before.Value)
+	}
+	after := provider.EvaluateAt(scheduled.Add(time.Minute), context.Background(), "summer-sale", nil)
+	if after.Value != true || after.Reason != openfeature.TargetingMatchReason {
+		t.Errorf("Expected the sale to be on after the scheduled time, got %v (%s)", after.Value, after.Reason)
+	}
+
+	// Times in other zones are compared in UTC
+	auckland := time.FixedZone("NZST", 12*60*60)
+	if result := provider.EvaluateAt(scheduled.Add(-time.Minute).In(auckland), context.Background(), "summer-sale", nil); result.Value != false {
+		t.Errorf("Expected the time to be compared in UTC, got %v", result.Value)
+	}
+}
+
+func TestTimeAttributeFollowsClock(t *testing.T) {
+	clock := newFakeClock()
+	provider := setupScheduleProvider(t, WithClock(clock.Now))
+
+	if result := provider.BooleanEvaluation(context.Background(), "summer-sale", false, nil); result.Value {
+		t.Error("Expected the sale to be off at the clock's time")
+	}
+	clock.Advance(time.Date(2030, 6, 2, 0, 0, 0, 0, time.UTC).Sub(clock.Now()))
+	if result := provider.BooleanEvaluation(context.Background(), "summer-sale", false, nil); !result.Value {
+		t.Errorf("Expected the sale to be on once the clock passes the scheduled time, now %v", clock.Now())
+	}
+
+	// An explicit time attribute takes precedence
+	evalCtx := openfeature.FlattenedContext{"now": "2020-01-01T00:00:00Z"}
+	if result := provider.BooleanEvaluation(context.Background(), "summer-sale", false, evalCtx); result.Value {
+		t.Error("Expected the explicit time attribute to win")
+	}
+}
@@ -0,0 +1,47 @@
+package growthbook
+
+import (
+	"context"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// EvalSession evaluates flags for a single evaluation context, such as the
+// user of a request, with one child GrowthBook client built when the session
+// is created. Every flag of the session sees the same attributes.
+type EvalSession struct {
+	provider *Provider
+}
+
+// WithContext returns a session evaluating flags for evalCtx. Building the
+// child client once, rather than for every evaluation, makes it cheaper to
+// evaluate many flags for a request. The session is a clone of the provider
+// carrying the attributes of evalCtx, so it's ready whenever the provider is.
+func (p *Provider) WithContext(evalCtx openfeature.FlattenedContext) *EvalSession {
+	return &EvalSession{provider: p.Clone(evalCtx)}
+}
+
+// Bool evaluates a boolean flag for the session's context
+func (s *EvalSession) Bool(ctx context.Context, flag string, defaultValue bool) openfeature.BoolResolutionDetail {
+	return s.provider.BooleanEvaluation(ctx, flag, defaultValue, nil)
+}
+
+// String evaluates a string flag for the session's context
+func (s *EvalSession) String(ctx context.Context, flag string, defaultValue string) openfeature.StringResolutionDetail {
+	return s.provider.StringEvaluation(ctx, flag, defaultValue, nil)
+}
+
+// Int evaluates an integer flag for the session's context
+func (s *EvalSession) Int(ctx context.Context, flag string, defaultValue int64) openfeature.IntResolutionDetail {
+	return s.provider.IntEvaluation(ctx, flag, defaultValue, nil)
+}
+
+// Float evaluates a numeric flag for the session's context
+func (s *EvalSession) Float(ctx context.Context, flag string, defaultValue float64) openfeature.FloatResolutionDetail {
+	return s.provider.FloatEvaluation(ctx, flag, defaultValue, nil)
+}
+
+// Object evaluates an object flag for the session's context
+func (s *EvalSession) Object(ctx context.Context, flag string, defaultValue interface{}) openfeature.InterfaceResolutionDetail {
+	return s.provider.ObjectEvaluation(ctx, flag, defaultValue, nil)
+}
@@ -0,0 +1,68 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestEvalSessionEvaluatesFlags(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	ctx := context.Background()
+
+	session := provider.WithContext(openfeature.FlattenedContext{"targetingKey": "user-1", "email": "user@growthbook.com"})
+
+	if result := session.Bool(ctx, "rules-test", false); !result.Value || result.Reason != openfeature.TargetingMatchReason {
+		t.Errorf("Expected the session's attributes to match the rule, got %v (%s)", result.Value, result.Reason)
+	}
+	if result := session.Bool(ctx, "bool-flag", false); !result.Value {
+		t.Error("Expected bool-flag to be true")
+	}
+	if result := session.String(ctx, "string-flag", ""); result.Value != "default-string" {
+		t.Errorf("Expected default-string, got %q", result.Value)
+	}
+	if result := session.Int(ctx, "int-flag", 0); result.Value != 42 {
+		t.Errorf("Expected 42, got %d", result.Value)
+	}
+	if result := session.Float(ctx, "number-flag", 0); result.Value != 42.5 {
+		t.Errorf("Expected 42.5, got %v", result.Value)
+	}
+	if result := session.Object(ctx, "object-flag", nil); result.Value.(map[string]interface{})["key"] != "value" {
+		t.Errorf("Expected the object flag, got %v", result.Value)
+	}
+	if result := session.Bool(ctx, "missing", true); result.ResolutionDetail().ErrorCode != openfeature.FlagNotFoundCode {
+		t.Errorf("Expected FLAG_NOT_FOUND, got %q", result.ResolutionDetail().ErrorCode)
+	}
+
+	// Another session doesn't see the first one's attributes
+	other := provider.WithContext(openfeature.FlattenedContext{"email": "other@example.com"})
+	if result := other.Bool(ctx, "rules-test", false); result.Value {
+		t.Error("Expected another session's attributes not to match the rule")
+	}
+}
+
+func BenchmarkEvalSession(b *testing.B) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	ctx := context.Background()
+	evalCtx := openfeature.FlattenedContext{"targetingKey": "user-1", "email": "user@growthbook.com", "country": "NZ"}
+	flags := []string{"bool-flag", "rules-test", "string-flag", "int-flag", "number-flag"}
+
+	b.Run("PerEvaluation", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, flag := range flags {
+				provider.ObjectEvaluation(ctx, flag, nil, evalCtx)
+			}
+		}
+	})
+	b.Run("Session", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			session := provider.WithContext(evalCtx)
+			for _, flag := range flags {
+				session.Object(ctx, flag, nil)
+			}
+		}
+	})
+}
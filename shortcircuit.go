@@ -0,0 +1,56 @@
+package growthbook
+
+import (
+	"context"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// noHashAttributeSource is the source of a flag's default value served
+// without evaluating the flag, because none of its experiments could include
+// the user (see WithHashAttributeShortCircuit)
+const noHashAttributeSource gb.FeatureResultSource = "noHashAttribute"
+
+// skipWithoutHashAttribute returns the result of flag without evaluating it if
+// the flag only has experiment rules and the attributes of evalCtx lack the
+// hash attribute of every one of them. GrowthBook would skip all such rules,
+// so the flag can only resolve to its default value. It returns nil whenever
+// evaluation could go otherwise, e.g. with forced variations or results, or a
+// fallback hash attribute.
+func (p *Provider) skipWithoutHashAttribute(ctx context.Context, flag string, evalCtx openfeature.FlattenedContext) *gb.FeatureResult {
+	if !p.hashShortCircuit || p.remoteEval || p.forcedResult(flag) != nil || p.overrideResult(flag) != nil {
+		return nil
+	}
+	if len(p.forcedVariations) > 0 || ctx.Value(forcedVariationsKey{}) != nil || ctx.Value(devURLKey{}) != nil {
+		return nil
+	}
+	feature := p.gbClient.Features()[flag]
+	if feature == nil || len(feature.Rules) == 0 {
+		return nil
+	}
+
+	attrs := p.buildAttributes(evalCtx)
+	if fallback, ok := p.fallbackAttrs[flag]; ok && hasHashValue(attrs[fallback]) {
+		return nil
+	}
+	for i := range feature.Rules {
+		rule := &feature.Rules[i]
+		// Prerequisites gate the flag rather than skipping a rule
+		if len(rule.Variations) == 0 || len(rule.ParentConditions) > 0 {
+			return nil
+		}
+		hashAttribute := rule.HashAttribute
+		if hashAttribute == "" {
+			hashAttribute = "id"
+		}
+		if hasHashValue(attrs[hashAttribute]) {
+			return nil
+		}
+	}
+
+	return &gb.FeatureResult{
+		Value:  feature.DefaultValue,
+		Source: noHashAttributeSource,
+	}
+}
@@ -0,0 +1,87 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+const experimentOnlyFeatures = `{
+	"exp-flag": {"defaultValue": "control", "rules": [
+		{"key": "exp-1", "variations": ["control", "treatment"]},
+		{"key": "exp-2", "hashAttribute": "deviceId", "variations": ["control", "treatment"]}
+	]},
+	"forced-flag": {"defaultValue": false, "rules": [
+		{"force": true},
+		{"key": "exp-3", "variations": [false, true]}
+	]}
+}`
+
+func setupShortCircuitProvider(tb testing.TB, enabled bool) *Provider {
+	tb.Helper()
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(experimentOnlyFeatures))
+	provider := NewProvider(gbClient, false, WithHashAttributeShortCircuit(enabled))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	return provider
+}
+
+func TestShortCircuitWithoutHashAttribute(t *testing.T) {
+	provider := setupShortCircuitProvider(t, true)
+
+	result := provider.StringEvaluation(context.Background(), "exp-flag", "default", openfeature.FlattenedContext{"country": "NZ"})
+	if result.Value != "control" || result.Reason != openfeature.DefaultReason {
+		t.Errorf("Expected the flag's default value with %s, got %q (%s)", openfeature.DefaultReason, result.Value, result.Reason)
+	}
+	if result.FlagMetadata["skippedNoHashAttribute"] != true {
+		t.Errorf("Expected the short-circuit marker, got %v", result.FlagMetadata)
+	}
+
+	// Having the hash attribute of any experiment means a full evaluation
+	result = provider.StringEvaluation(context.Background(), "exp-flag", "default", openfeature.FlattenedContext{"deviceId": "device-1"})
+	if _, ok := result.FlagMetadata["skippedNoHashAttribute"]; ok || result.Reason != openfeature.TargetingMatchReason {
+		t.Errorf("Expected a full evaluation with a hash attribute, got %s %v", result.Reason, result.FlagMetadata)
+	}
+
+	// Flags with other rules are always evaluated
+	forced := provider.BooleanEvaluation(context.Background(), "forced-flag", false, nil)
+	if !forced.Value {
+		t.Errorf("Expected the force rule to apply, got %v %v", forced.Value, forced.FlagMetadata)
+	}
+
+	// Forced variations bypass the hash attribute
+	ctx := ForceVariation(context.Background(), "exp-flag", 1)
+	if result := provider.StringEvaluation(ctx, "exp-flag", "default", nil); result.Value != "treatment" {
+		t.Errorf("Expected the forced variation, got %q", result.Value)
+	}
+}
+
+func TestShortCircuitDisabledByDefault(t *testing.T) {
+	provider := setupShortCircuitProvider(t, false)
+
+	result := provider.StringEvaluation(context.Background(), "exp-flag", "default", nil)
+	if result.Value != "control" {
+		t.Errorf("Expected the flag's default value, got %q", result.Value)
+	}
+	if _, ok := result.FlagMetadata["skippedNoHashAttribute"]; ok {
+		t.Errorf("Expected no short-circuit, got %v", result.FlagMetadata)
+	}
+}
+
+func BenchmarkHashAttributeShortCircuit(b *testing.B) {
+	evalCtx := openfeature.FlattenedContext{"country": "NZ"}
+	for _, enabled := range []bool{false, true} {
+		name := "evaluated"
+		if enabled {
+			name = "short-circuited"
+		}
+		b.Run(name, func(b *testing.B) {
+			provider := setupShortCircuitProvider(b, enabled)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				provider.StringEvaluation(context.Background(), "exp-flag", "default", evalCtx)
+			}
+		})
+	}
+}
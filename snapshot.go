@@ -0,0 +1,79 @@
+package growthbook
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+)
+
+// Snapshot is a point-in-time view of the features loaded by a provider. The
+// GrowthBook client replaces its feature set on every update rather than
+// modifying it, so a snapshot stays unchanged when features are reloaded.
+type Snapshot struct {
+	Features gb.FeatureMap
+	TakenAt  time.Time
+}
+
+// Snapshot returns the currently loaded features
+func (p *Provider) Snapshot() *Snapshot {
+	return &Snapshot{
+		Features: p.gbClient.Features(),
+		TakenAt:  p.now(),
+	}
+}
+
+// FlagChange is the kind of change a FlagDiff describes
+type FlagChange string
+
+const (
+	FlagAdded   FlagChange = "added"
+	FlagRemoved FlagChange = "removed"
+	FlagChanged FlagChange = "changed"
+)
+
+// FlagDiff describes how a flag differs between two snapshots. Old is nil for
+// added flags and New is nil for removed ones.
+type FlagDiff struct {
+	Flag   string
+	Change FlagChange
+	Old    *gb.Feature
+	New    *gb.Feature
+}
+
+// DiffSnapshots returns the flags that were added, removed or changed (in
+// their default value or rules) between old and new, sorted by flag key. A nil
+// snapshot is treated as having no features.
+func (p *Provider) DiffSnapshots(old, new *Snapshot) []FlagDiff {
+	return diffFeatures(snapshotFeatures(old), snapshotFeatures(new))
+}
+
+// snapshotFeatures returns the features of a possibly nil snapshot
+func snapshotFeatures(snapshot *Snapshot) gb.FeatureMap {
+	if snapshot == nil {
+		return nil
+	}
+	return snapshot.Features
+}
+
+// diffFeatures compares two feature sets, sorted by flag key
+func diffFeatures(before, after gb.FeatureMap) []FlagDiff {
+	var diffs []FlagDiff
+	for key, feature := range after {
+		previous, ok := before[key]
+		switch {
+		case !ok:
+			diffs = append(diffs, FlagDiff{Flag: key, Change: FlagAdded, New: feature})
+		case !reflect.DeepEqual(previous, feature):
+			diffs = append(diffs, FlagDiff{Flag: key, Change: FlagChanged, Old: previous, New: feature})
+		}
+	}
+	for key, feature := range before {
+		if _, ok := after[key]; !ok {
+			diffs = append(diffs, FlagDiff{Flag: key, Change: FlagRemoved, Old: feature})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Flag < diffs[j].Flag })
+	return diffs
+}
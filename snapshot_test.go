@@ -0,0 +1,72 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"kept": {"defaultValue": 1},
+		"value-changed": {"defaultValue": "before"},
+		"rules-changed": {"defaultValue": false},
+		"removed": {"defaultValue": true}
+	}`))
+	provider := NewProvider(gbClient, false)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	before := provider.Snapshot()
+
+	_ = gbClient.SetJSONFeatures(`{
+		"kept": {"defaultValue": 1},
+		"value-changed": {"defaultValue": "after"},
+		"rules-changed": {"defaultValue": false, "rules": [{"condition": {"plan": "pro"}, "force": true}]},
+		"added": {"defaultValue": 2}
+	}`)
+	after := provider.Snapshot()
+
+	diffs := provider.DiffSnapshots(before, after)
+	expected := []struct {
+		flag   string
+		change FlagChange
+	}{
+		{"added", FlagAdded},
+		{"removed", FlagRemoved},
+		{"rules-changed", FlagChanged},
+		{"value-changed", FlagChanged},
+	}
+	if len(diffs) != len(expected) {
+		t.Fatalf("Expected %d diffs, got %+v", len(expected), diffs)
+	}
+	for i, e := range expected {
+		if diffs[i].Flag != e.flag || diffs[i].Change != e.change {
+			t.Errorf("Expected diff %d to be %s %s, got %s %s", i, e.flag, e.change, diffs[i].Flag, diffs[i].Change)
+		}
+	}
+	if diffs[0].Old != nil || diffs[0].New == nil || diffs[1].Old == nil || diffs[1].New != nil {
+		t.Errorf("Expected added and removed flags to carry only their new and old definitions, got %+v", diffs[:2])
+	}
+	if diffs[3].Old.DefaultValue != "before" || diffs[3].New.DefaultValue != "after" {
+		t.Errorf("Expected changed flag to carry both definitions, got %+v", diffs[3])
+	}
+
+	// The earlier snapshot wasn't affected by the reload
+	if _, ok := before.Features["removed"]; !ok {
+		t.Error("Expected the earlier snapshot to keep its features")
+	}
+}
+
+func TestDiffSnapshotsAgainstNil(t *testing.T) {
+	provider := setupTestProvider()
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	diffs := provider.DiffSnapshots(nil, provider.Snapshot())
+	if len(diffs) != len(provider.GetClient().Features()) {
+		t.Errorf("Expected every flag to be added relative to nil, got %d diffs", len(diffs))
+	}
+	if diffs := provider.DiffSnapshots(provider.Snapshot(), provider.Snapshot()); len(diffs) != 0 {
+		t.Errorf("Expected no diffs between equal snapshots, got %+v", diffs)
+	}
+}
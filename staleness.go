@@ -0,0 +1,74 @@
+package growthbook
+
+import (
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// lastRefresh returns the time features were last fetched successfully. That's
+// the fetch tracker's last success if one is configured, or else the time Init
+// loaded the features.
+func (p *Provider) lastRefresh() time.Time {
+	if p.parent != nil {
+		return p.parent.lastRefresh()
+	}
+
+	p.stateMutex.RLock()
+	last := p.lastLoaded
+	p.stateMutex.RUnlock()
+
+	if p.fetchTracker != nil {
+		if fetched := p.fetchTracker.LastSuccess(); fetched.After(last) {
+			last = fetched
+		}
+	}
+	return last
+}
+
+// isStale reports whether features were last fetched longer ago than the
+// staleness TTL. Staleness can only be detected with a fetch tracker.
+func (p *Provider) isStale() bool {
+	if p.staleTTL <= 0 || p.fetchTracker == nil {
+		return false
+	}
+	last := p.lastRefresh()
+	if last.IsZero() {
+		return false
+	}
+	return p.now().Sub(last) > p.staleTTL
+}
+
+// updateStaleState moves the provider between ReadyState and StaleState when
+// WithStaleState is enabled, emitting the matching event for the OpenFeature SDK.
+func (p *Provider) updateStaleState() {
+	if p.parent != nil {
+		p.parent.updateStaleState()
+		return
+	}
+	if !p.staleState {
+		return
+	}
+	stale := p.isStale()
+
+	var event openfeature.EventType
+	p.stateMutex.Lock()
+	old := p.state
+	if stale && p.state == openfeature.ReadyState {
+		p.state = openfeature.StaleState
+		event = openfeature.ProviderStale
+	} else if !stale && p.state == openfeature.StaleState {
+		p.state = openfeature.ReadyState
+		event = openfeature.ProviderReady
+	}
+	state := p.state
+	p.stateMutex.Unlock()
+	p.notifyState(old, state)
+
+	switch event {
+	case openfeature.ProviderStale:
+		p.emit(event, "GrowthBook features have not been refreshed within the staleness TTL")
+	case openfeature.ProviderReady:
+		p.emit(event, "GrowthBook features were refreshed")
+	}
+}
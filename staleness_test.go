@@ -0,0 +1,210 @@
+package growthbook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// fakeClock is a manually advanced clock for time-dependent tests
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// stalenessServer serves features once with an etag and answers 304 Not
+// Modified afterwards, or 500 while failing is set.
+type stalenessServer struct {
+	*httptest.Server
+	failing atomic.Bool
+}
+
+func newStalenessServer(t *testing.T) *stalenessServer {
+	t.Helper()
+	s := &stalenessServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Etag", `"v1"`)
+		_, _ = w.Write([]byte(`{"features": {"bool-flag": {"defaultValue": true}}, "dateUpdated": "2024-01-01T00:00:00Z"}`))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// setupStalenessProvider creates an initialized provider polling server every
+// interval, with its fetch tracker driven by clock.
+func setupStalenessProvider(t *testing.T, server *stalenessServer, interval time.Duration, clock *fakeClock, options ...interface{}) (*Provider, *FetchTracker) {
+	t.Helper()
+	tracker := NewFetchTracker(nil)
+	gbClient, err := gb.NewClient(
+		context.Background(),
+		gb.WithApiHost(server.URL),
+		gb.WithClientKey("sdk-test"),
+		gb.WithHttpClient(tracker.HTTPClient()),
+		gb.WithPollDataSource(interval),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create GrowthBook client: %v", err)
+	}
+
+	options = append([]interface{}{5 * time.Second, WithFetchTracker(tracker), WithClock(clock.Now)}, options...)
+	provider := NewProvider(gbClient, options...)
+
+	if err := provider.Init(openfeature.NewEvaluationContext("test-user", nil)); err != nil {
+		t.Fatalf("Provider initialization failed: %v", err)
+	}
+	t.Cleanup(provider.Shutdown)
+	return provider, tracker
+}
+
+// waitForFetchAfter waits until the tracker records a successful fetch after since
+func waitForFetchAfter(t *testing.T, tracker *FetchTracker, since time.Time) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !tracker.LastSuccess().After(since) {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for a successful fetch")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestFlagStalenessMarker(t *testing.T) {
+	clock := newFakeClock()
+	server := newStalenessServer(t)
+	provider, _ := setupStalenessProvider(t, server, time.Hour, clock, WithFlagStaleness(time.Minute))
+
+	result := provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+	if _, ok := result.FlagMetadata["stale"]; ok {
+		t.Error("Expected no stale marker right after init")
+	}
+
+	// No fetch happens within the TTL
+	clock.Advance(2 * time.Minute)
+
+	result = provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+	if stale, _ := result.FlagMetadata["stale"].(bool); !stale {
+		t.Errorf("Expected stale marker after TTL elapsed, got metadata %v", result.FlagMetadata)
+	}
+	if !result.Value {
+		t.Error("Expected stale value to still be served")
+	}
+	if provider.Status() != openfeature.ReadyState {
+		t.Errorf("Expected provider to stay ready without WithStaleState, got %v", provider.Status())
+	}
+}
+
+func TestFlagStalenessNotModifiedIsFresh(t *testing.T) {
+	clock := newFakeClock()
+	server := newStalenessServer(t)
+	provider, tracker := setupStalenessProvider(t, server, 10*time.Millisecond, clock, WithFlagStaleness(time.Minute))
+
+	// The poller keeps getting 304 Not Modified, which leaves the client's features untouched
+	clock.Advance(2 * time.Minute)
+	waitForFetchAfter(t, tracker, clock.Now().Add(-time.Second))
+
+	result := provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+	if _, ok := result.FlagMetadata["stale"]; ok {
+		t.Errorf("Expected no stale marker while the server answers 304, got metadata %v", result.FlagMetadata)
+	}
+}
+
+func TestFlagStalenessState(t *testing.T) {
+	clock := newFakeClock()
+	server := newStalenessServer(t)
+	server.failing.Store(false)
+	provider, tracker := setupStalenessProvider(t, server, 10*time.Millisecond, clock,
+		WithFlagStaleness(time.Minute), WithStaleState(true))
+
+	server.failing.Store(true)
+	clock.Advance(2 * time.Minute)
+	_ = provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+	if provider.Status() != openfeature.StaleState {
+		t.Fatalf("Expected stale state while fetches fail, got %v", provider.Status())
+	}
+	expectEvent(t, provider, openfeature.ProviderStale)
+
+	// A successful fetch makes the provider ready again
+	server.failing.Store(false)
+	waitForFetchAfter(t, tracker, clock.Now().Add(-time.Second))
+	if provider.Status() != openfeature.ReadyState {
+		t.Errorf("Expected ready state after a successful fetch, got %v", provider.Status())
+	}
+	expectEvent(t, provider, openfeature.ProviderReady)
+}
+
+func TestFlagStalenessRequiresFetchTracker(t *testing.T) {
+	clock := newFakeClock()
+	provider := setupTestProvider(WithFlagStaleness(time.Minute), WithClock(clock.Now))
+	_ = provider.Init(openfeature.NewEvaluationContext("test-user", nil))
+
+	clock.Advance(time.Hour)
+	result := provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+	if _, ok := result.FlagMetadata["stale"]; ok {
+		t.Error("Expected no stale marker without a fetch tracker")
+	}
+}
+
+func TestWithClockDrivesStaleTransition(t *testing.T) {
+	clock := newFakeClock()
+	server := newStalenessServer(t)
+	provider, _ := setupStalenessProvider(t, server, time.Hour, clock, WithFlagStaleness(time.Minute), WithStaleState(true))
+
+	clock.Advance(59 * time.Second)
+	if provider.Status() != openfeature.ReadyState {
+		t.Fatalf("Expected ready state within the TTL, got %v", provider.Status())
+	}
+
+	// Evaluations re-check staleness against the injected clock
+	clock.Advance(2 * time.Second)
+	provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+	if provider.Status() != openfeature.StaleState {
+		t.Errorf("Expected stale state once the clock passes the TTL, got %v", provider.Status())
+	}
+	if last := provider.Info().LastLoaded; !last.Equal(clock.Now().Add(-61 * time.Second)) {
+		t.Errorf("Expected LastLoaded from the injected clock, got %v", last)
+	}
+}
+
+// expectEvent waits for the next provider event and checks its type
+func expectEvent(t *testing.T, provider *Provider, expected openfeature.EventType) {
+	t.Helper()
+	select {
+	case event := <-provider.EventChannel():
+		if event.EventType != expected {
+			t.Errorf("Expected %s event, got %s", expected, event.EventType)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("Timed out waiting for %s event", expected)
+	}
+}
@@ -0,0 +1,67 @@
+package growthbook
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// stateListeners holds the callbacks registered with OnStateChange, in
+// registration order
+type stateListeners struct {
+	mu   sync.Mutex
+	next int
+	fns  []stateListener
+}
+
+type stateListener struct {
+	id int
+	fn func(old, new openfeature.State)
+}
+
+// OnStateChange registers fn to be called on every transition of the
+// provider's state, such as READY to STALE or READY to ERROR, with the state
+// before and after it. fn is called after the state lock is released, so it
+// may call Status or other provider methods. The returned function removes
+// the callback.
+//
+// A clone's state follows its parent's, so its callbacks are registered on
+// the parent.
+func (p *Provider) OnStateChange(fn func(old, new openfeature.State)) (unsubscribe func()) {
+	if p.parent != nil {
+		return p.parent.OnStateChange(fn)
+	}
+
+	l := &p.listeners
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	id := l.next
+	l.next++
+	l.fns = append(l.fns, stateListener{id: id, fn: fn})
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			l.fns = slices.DeleteFunc(l.fns, func(s stateListener) bool { return s.id == id })
+		})
+	}
+}
+
+// notifyState calls the OnStateChange callbacks if old and new differ. It
+// must be called without stateMutex held.
+func (p *Provider) notifyState(old, new openfeature.State) {
+	if old == new {
+		return
+	}
+	l := &p.listeners
+	l.mu.Lock()
+	fns := slices.Clone(l.fns)
+	l.mu.Unlock()
+
+	for _, s := range fns {
+		p.safely("state change callback", func() { s.fn(old, new) })
+	}
+}
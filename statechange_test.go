@@ -0,0 +1,108 @@
+package growthbook
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// stateRecorder records the transitions reported to OnStateChange
+type stateRecorder struct {
+	mu          sync.Mutex
+	transitions []string
+}
+
+func (r *stateRecorder) record(old, new openfeature.State) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.transitions = append(r.transitions, fmt.Sprintf("%s->%s", old, new))
+}
+
+func (r *stateRecorder) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.transitions...)
+}
+
+func TestOnStateChangeObservesTransitions(t *testing.T) {
+	clock := newFakeClock()
+	server := newStalenessServer(t)
+	tracker := NewFetchTracker(nil)
+	gbClient, _ := gb.NewClient(
+		context.Background(),
+		gb.WithApiHost(server.URL),
+		gb.WithClientKey("sdk-test"),
+		gb.WithHttpClient(tracker.HTTPClient()),
+		gb.WithPollDataSource(10*time.Millisecond),
+	)
+	provider := NewProvider(gbClient, 5*time.Second, WithFetchTracker(tracker), WithClock(clock.Now),
+		WithFlagStaleness(time.Minute), WithStaleState(true))
+
+	recorder := &stateRecorder{}
+	provider.OnStateChange(func(old, new openfeature.State) {
+		// Called without the state lock held, so reading the state doesn't deadlock
+		if provider.Status() != new {
+			t.Errorf("Expected Status to report %s in the callback, got %s", new, provider.Status())
+		}
+		recorder.record(old, new)
+	})
+
+	if err := provider.Init(openfeature.NewEvaluationContext("", nil)); err != nil {
+		t.Fatalf("Provider initialization failed: %v", err)
+	}
+
+	// Fetches fail past the staleness TTL, then succeed again
+	server.failing.Store(true)
+	clock.Advance(2 * time.Minute)
+	provider.BooleanEvaluation(context.Background(), "bool-flag", false, nil)
+	server.failing.Store(false)
+	waitForFetchAfter(t, tracker, clock.Now().Add(-time.Second))
+	provider.Shutdown()
+
+	expected := []string{
+		"NOT_READY->READY",
+		"READY->STALE",
+		"STALE->READY",
+		"READY->NOT_READY",
+	}
+	if got := recorder.get(); fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Errorf("Expected transitions %v, got %v", expected, got)
+	}
+}
+
+func TestOnStateChangeReportsInitError(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background())
+	provider := NewProvider(gbClient, false, WithRemoteEval(true))
+
+	recorder := &stateRecorder{}
+	provider.OnStateChange(recorder.record)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	expected := []string{"NOT_READY->ERROR"}
+	if got := recorder.get(); fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Errorf("Expected transitions %v, got %v", expected, got)
+	}
+}
+
+func TestOnStateChangeUnsubscribe(t *testing.T) {
+	provider := setupTestProvider()
+
+	kept, removed := &stateRecorder{}, &stateRecorder{}
+	provider.OnStateChange(kept.record)
+	unsubscribe := provider.OnStateChange(removed.record)
+	unsubscribe()
+	unsubscribe()
+
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	if got := removed.get(); len(got) != 0 {
+		t.Errorf("Expected no transitions after unsubscribing, got %v", got)
+	}
+	if got := kept.get(); len(got) != 1 {
+		t.Errorf("Expected the remaining callback to observe Init, got %v", got)
+	}
+}
@@ -0,0 +1,21 @@
+package growthbook
+
+// applyTransform passes the resolved value of flag through the transform set
+// with WithValueTransform, if any. A transform returning a value of another
+// type, or panicking, is ignored, as it can't be returned to the caller.
+func applyTransform[T any](p *Provider, flag string, value T) T {
+	transform, ok := p.transforms[flag]
+	if !ok {
+		return value
+	}
+	var out interface{}
+	if !p.safely("value transform of flag '"+flag+"'", func() { out = transform(value) }) {
+		return value
+	}
+	transformed, ok := out.(T)
+	if !ok {
+		p.log().Warn("Ignoring value transform that changed the value's type", "flag", flag)
+		return value
+	}
+	return transformed
+}
@@ -0,0 +1,45 @@
+package growthbook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestValueTransformClampsInt(t *testing.T) {
+	clamp := func(value interface{}) interface{} {
+		if v := value.(int64); v > 10 {
+			return int64(10)
+		}
+		return value
+	}
+	provider := setupTestProvider(WithValueTransform("int-flag", clamp))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.IntEvaluation(context.Background(), "int-flag", 0, nil)
+	if result.Value != 10 {
+		t.Errorf("Expected the clamped value 10, got %d", result.Value)
+	}
+	if result.Reason != openfeature.DefaultReason {
+		t.Errorf("Expected the reason to be kept, got %s", result.Reason)
+	}
+	if result.FlagMetadata["source"] != "defaultValue" {
+		t.Errorf("Expected the metadata to be kept, got %v", result.FlagMetadata)
+	}
+
+	// Other flags aren't transformed
+	if number := provider.FloatEvaluation(context.Background(), "number-flag", 0, nil); number.Value != 42.5 {
+		t.Errorf("Expected number-flag to be untouched, got %v", number.Value)
+	}
+}
+
+func TestValueTransformChangingTypeIgnored(t *testing.T) {
+	provider := setupTestProvider(WithValueTransform("string-flag", func(interface{}) interface{} { return 1 }))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	result := provider.StringEvaluation(context.Background(), "string-flag", "", nil)
+	if result.Value != "default-string" {
+		t.Errorf("Expected the untransformed value, got %q", result.Value)
+	}
+}
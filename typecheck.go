@@ -0,0 +1,48 @@
+package growthbook
+
+import (
+	"encoding/json"
+
+	gb "github.com/growthbook/growthbook-golang"
+)
+
+// warnTypeChanges logs a warning for every flag among diffs whose default
+// value changed type, e.g. from a boolean to a string, which breaks callers
+// evaluating it with the old type, and returns their keys. Flags without a
+// default value on either side are left out.
+func (p *Provider) warnTypeChanges(diffs []FlagDiff) []string {
+	var changed []string
+	for _, diff := range diffs {
+		if diff.Change != FlagChanged {
+			continue
+		}
+		before, after := featureValueKind(diff.Old), featureValueKind(diff.New)
+		if before != "" && after != "" && before != after {
+			p.log().Warn("Flag changed type on reload", "flag", diff.Flag, "before", before, "after", after)
+			changed = append(changed, diff.Flag)
+		}
+	}
+	return changed
+}
+
+// featureValueKind names the JSON type of a feature's default value, or
+// returns "" if it has none
+func featureValueKind(feature *gb.Feature) string {
+	if feature == nil {
+		return ""
+	}
+	switch feature.DefaultValue.(type) {
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64, json.Number, int, int64:
+		return "number"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	default:
+		return ""
+	}
+}
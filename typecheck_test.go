@@ -0,0 +1,70 @@
+package growthbook
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestTypeChangeOnReloadIsWarned(t *testing.T) {
+	var logs bytes.Buffer
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{
+		"flipped": {"defaultValue": true},
+		"kept-type": {"defaultValue": "before"}
+	}`))
+	provider := NewProvider(gbClient, false,
+		WithChangeEvents(10*time.Millisecond),
+		WithLogger(slog.New(slog.NewTextHandler(&logs, nil))),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	defer provider.Shutdown()
+
+	_ = gbClient.SetJSONFeatures(`{
+		"flipped": {"defaultValue": "on"},
+		"kept-type": {"defaultValue": "after"}
+	}`)
+
+	select {
+	case event := <-provider.EventChannel():
+		expected := []string{"flipped"}
+		if !reflect.DeepEqual(event.EventMetadata["typeChanged"], expected) {
+			t.Errorf("Expected typeChanged %v, got %v", expected, event.EventMetadata)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for a configuration change event")
+	}
+
+	// The event is emitted after the warning is logged
+	if !strings.Contains(logs.String(), "Flag changed type on reload") || !strings.Contains(logs.String(), "flag=flipped") {
+		t.Errorf("Expected a warning naming the flipped flag, got %q", logs.String())
+	}
+	if strings.Contains(logs.String(), "kept-type") {
+		t.Errorf("Expected no warning for a flag keeping its type, got %q", logs.String())
+	}
+}
+
+func TestFeatureValueKind(t *testing.T) {
+	tests := []struct {
+		value    gb.FeatureValue
+		expected string
+	}{
+		{true, "boolean"},
+		{"on", "string"},
+		{1.5, "number"},
+		{map[string]interface{}{}, "object"},
+		{[]interface{}{}, "array"},
+		{nil, ""},
+	}
+	for _, tt := range tests {
+		if kind := featureValueKind(&gb.Feature{DefaultValue: tt.value}); kind != tt.expected {
+			t.Errorf("Expected kind %q for %v, got %q", tt.expected, tt.value, kind)
+		}
+	}
+}
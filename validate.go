@@ -0,0 +1,127 @@
+package growthbook
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// ValidateAttributeCoverage inspects the targeting conditions and hash
+// attributes of all loaded features and returns the attribute keys they
+// reference that are missing from sampleCtx, sorted alphabetically. Nested
+// attributes are reported in dotted form (e.g. "company.plan"). sampleCtx is
+// converted to attributes like an evaluation context, so its targeting key
+// covers "id" and attributes dropped by the allowlist are reported missing.
+//
+// This is a development-time lint helper for catching dashboards that target
+// attributes the application never supplies. GrowthBook doesn't expose parsed
+// conditions, so they are inspected via reflection. If a condition can't be
+// understood, for example because a GrowthBook update changed its internals,
+// an error is returned rather than an incomplete list.
+func (p *Provider) ValidateAttributeCoverage(sampleCtx openfeature.FlattenedContext) ([]string, error) {
+	features := p.gbClient.Features()
+	if len(features) == 0 {
+		return nil, errors.New("no features loaded")
+	}
+
+	referenced := map[string]struct{}{}
+	for key, feature := range features {
+		if feature == nil {
+			continue
+		}
+		for _, rule := range feature.Rules {
+			if err := collectConditionAttributes(reflect.ValueOf(rule.Condition), referenced); err != nil {
+				return nil, fmt.Errorf("feature %q: %w", key, err)
+			}
+
+			// Experiments and percentage rollouts hash on an attribute
+			if len(rule.Variations) > 0 || rule.Coverage != nil || rule.Range != nil {
+				hashAttribute := rule.HashAttribute
+				if hashAttribute == "" {
+					hashAttribute = "id"
+				}
+				referenced[hashAttribute] = struct{}{}
+			}
+			for _, filter := range rule.Filters {
+				if filter.Attribute != "" {
+					referenced[filter.Attribute] = struct{}{}
+				}
+			}
+		}
+	}
+
+	attrs := p.contextAttributes(sampleCtx)
+	var missing []string
+	for key := range referenced {
+		if !hasAttributePath(attrs, strings.Split(key, ".")) {
+			missing = append(missing, key)
+		}
+	}
+	sort.Strings(missing)
+	return missing, nil
+}
+
+// collectConditionAttributes walks a parsed GrowthBook condition and records the
+// attribute paths of its field conditions. Every leaf of a condition is a field
+// condition, so any other leaf means the condition's layout isn't recognized.
+func collectConditionAttributes(v reflect.Value, keys map[string]struct{}) error {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return collectConditionAttributes(v.Elem(), keys)
+	case reflect.Slice, reflect.Array:
+		// Logical operators ($and, $or, $nor) hold a list of conditions
+		for i := 0; i < v.Len(); i++ {
+			if err := collectConditionAttributes(v.Index(i), keys); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+		switch v.Type().Name() {
+		case "FieldCond":
+			// Conditions nested below a field condition (e.g. $elemMatch) apply
+			// to the field's value rather than to top-level attributes
+			path := v.FieldByName("path")
+			if path.Kind() != reflect.Slice || path.Type().Elem().Kind() != reflect.String {
+				return errors.New("unrecognized field condition: no attribute path")
+			}
+			parts := make([]string, path.Len())
+			for i := range parts {
+				parts[i] = path.Index(i).String()
+			}
+			keys[strings.Join(parts, ".")] = struct{}{}
+			return nil
+		case "Base", "NotCond":
+			for i := 0; i < v.NumField(); i++ {
+				if err := collectConditionAttributes(v.Field(i), keys); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("unrecognized condition of type %s", v.Type())
+}
+
+// hasAttributePath reports whether the nested path exists in attrs
+func hasAttributePath(attrs map[string]interface{}, path []string) bool {
+	value, ok := attrs[path[0]]
+	if !ok {
+		return false
+	}
+	if len(path) == 1 {
+		return true
+	}
+	nested, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	return hasAttributePath(nested, path[1:])
+}
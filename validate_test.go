@@ -0,0 +1,117 @@
+package growthbook
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	gb "github.com/growthbook/growthbook-golang"
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestValidateAttributeCoverage(t *testing.T) {
+	featuresJSON := `{
+		"pro-feature": {
+			"defaultValue": false,
+			"rules": [
+				{"condition": {"plan": "pro"}, "force": true},
+				{"condition": {"$or": [{"country": "US"}, {"company.size": {"$gt": 100}}]}, "force": true}
+			]
+		},
+		"experiment": {
+			"defaultValue": "a",
+			"rules": [{"variations": ["a", "b"], "hashAttribute": "deviceId"}]
+		}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, false)
+
+	missing, err := provider.ValidateAttributeCoverage(openfeature.FlattenedContext{
+		"country": "US",
+		"company": map[string]interface{}{"size": 10},
+	})
+	if err != nil {
+		t.Fatalf("ValidateAttributeCoverage failed: %v", err)
+	}
+
+	expected := []string{"deviceId", "plan"}
+	if !reflect.DeepEqual(missing, expected) {
+		t.Errorf("Expected missing attributes %v, got %v", expected, missing)
+	}
+}
+
+func TestValidateAttributeCoverageNoFeatures(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background())
+	provider := NewProvider(gbClient, false)
+
+	if _, err := provider.ValidateAttributeCoverage(nil); err == nil {
+		t.Error("Expected an error when no features are loaded")
+	}
+}
+
+func TestValidateAttributeCoverageEmptyFeatures(t *testing.T) {
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(`{}`))
+	provider := NewProvider(gbClient, false)
+
+	if _, err := provider.ValidateAttributeCoverage(nil); err == nil {
+		t.Error("Expected an empty feature set to be reported like a missing one")
+	}
+}
+
+// unknownCond stands in for a condition type the reflection walk doesn't know
+type unknownCond struct {
+	attribute string
+}
+
+func TestCollectConditionAttributesUnrecognized(t *testing.T) {
+	keys := map[string]struct{}{}
+	if err := collectConditionAttributes(reflect.ValueOf([]interface{}{unknownCond{"plan"}}), keys); err == nil {
+		t.Error("Expected an error for an unrecognized condition")
+	}
+
+	// A field condition without a recognizable path fails too
+	type FieldCond struct {
+		attribute string
+	}
+	if err := collectConditionAttributes(reflect.ValueOf(FieldCond{"plan"}), keys); err == nil {
+		t.Error("Expected an error for a field condition without a path")
+	}
+}
+
+func TestValidateAttributeCoverageEmptyCondition(t *testing.T) {
+	featuresJSON := `{
+		"always": {"defaultValue": true, "rules": [{"condition": {}, "force": false}, {"force": true}]}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, false)
+
+	missing, err := provider.ValidateAttributeCoverage(nil)
+	if err != nil {
+		t.Fatalf("Expected empty conditions to be accepted, got %v", err)
+	}
+	if len(missing) != 0 {
+		t.Errorf("Expected no missing attributes, got %v", missing)
+	}
+}
+
+func TestValidateAttributeCoverageTargetingKey(t *testing.T) {
+	featuresJSON := `{
+		"rollout": {"defaultValue": false, "rules": [{"coverage": 0.5, "force": true}]}
+	}`
+	gbClient, _ := gb.NewClient(context.Background(), gb.WithJsonFeatures(featuresJSON))
+	provider := NewProvider(gbClient, false)
+
+	// The targeting key is passed to GrowthBook as "id"
+	missing, err := provider.ValidateAttributeCoverage(openfeature.FlattenedContext{openfeature.TargetingKey: "user-123"})
+	if err != nil {
+		t.Fatalf("ValidateAttributeCoverage failed: %v", err)
+	}
+	if len(missing) != 0 {
+		t.Errorf("Expected the targeting key to cover id, got %v", missing)
+	}
+
+	missing, _ = provider.ValidateAttributeCoverage(openfeature.FlattenedContext{openfeature.TargetingKey: ""})
+	if !reflect.DeepEqual(missing, []string{"id"}) {
+		t.Errorf("Expected an empty targeting key to leave id missing, got %v", missing)
+	}
+}
@@ -0,0 +1,60 @@
+package growthbook
+
+import (
+	"runtime/debug"
+	"sync"
+)
+
+const (
+	providerModule = "github.com/growthbook/growthbook-openfeature-provider-go"
+	sdkModule      = "github.com/growthbook/growthbook-golang"
+
+	// unknownVersion is reported for modules whose version isn't recorded in
+	// the binary's build info
+	unknownVersion = "unknown"
+)
+
+// VersionInfo reports the versions of the provider module and of the
+// GrowthBook Go SDK it was built with, as recorded in the binary's build info.
+// A module built from a local checkout reports "(devel)", and "unknown" is
+// reported when the binary has no build info.
+type VersionInfo struct {
+	Provider string
+	SDK      string
+}
+
+// moduleVersions reads the module versions once, as build info doesn't
+// change while the binary runs
+var moduleVersions = sync.OnceValue(func() VersionInfo {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return VersionInfo{Provider: unknownVersion, SDK: unknownVersion}
+	}
+	return VersionInfo{
+		Provider: moduleVersion(info, providerModule),
+		SDK:      moduleVersion(info, sdkModule),
+	}
+})
+
+// moduleVersion returns the version of the module at path in info
+func moduleVersion(info *debug.BuildInfo, path string) string {
+	modules := append([]*debug.Module{&info.Main}, info.Deps...)
+	for _, module := range modules {
+		if module.Path != path {
+			continue
+		}
+		if module.Replace != nil && module.Replace.Version != "" {
+			return module.Replace.Version
+		}
+		if module.Version != "" {
+			return module.Version
+		}
+	}
+	return unknownVersion
+}
+
+// Version returns the versions of the provider and the GrowthBook SDK, for
+// support requests and compatibility checks.
+func (p *Provider) Version() VersionInfo {
+	return moduleVersions()
+}
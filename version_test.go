@@ -0,0 +1,40 @@
+package growthbook
+
+import (
+	"runtime/debug"
+	"testing"
+)
+
+func TestVersion(t *testing.T) {
+	provider := setupTestProvider()
+
+	version := provider.Version()
+	if version.Provider == "" || version.SDK == "" {
+		t.Errorf("Expected non-empty versions, got %+v", version)
+	}
+	// Tests are built with the SDK as a dependency of this module
+	if version.SDK == unknownVersion {
+		t.Errorf("Expected the SDK version from the build info, got %q", version.SDK)
+	}
+}
+
+func TestModuleVersion(t *testing.T) {
+	info := &debug.BuildInfo{
+		Main: debug.Module{Path: "example.com/app", Version: "(devel)"},
+		Deps: []*debug.Module{
+			{Path: providerModule, Version: "v1.2.0"},
+			{Path: sdkModule, Version: "v0.2.1", Replace: &debug.Module{Path: "../growthbook-golang"}},
+		},
+	}
+
+	if got := moduleVersion(info, providerModule); got != "v1.2.0" {
+		t.Errorf("Expected v1.2.0, got %q", got)
+	}
+	// A local replacement has no version of its own
+	if got := moduleVersion(info, sdkModule); got != "v0.2.1" {
+		t.Errorf("Expected v0.2.1, got %q", got)
+	}
+	if got := moduleVersion(info, "example.com/missing"); got != unknownVersion {
+		t.Errorf("Expected %q, got %q", unknownVersion, got)
+	}
+}
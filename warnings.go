@@ -0,0 +1,131 @@
+package growthbook
+
+import (
+	"sync"
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+// warningKey identifies warnings that are rate-limited together
+type warningKey struct {
+	flag string
+	code openfeature.ErrorCode
+}
+
+// warningBucket is the token bucket of one warningKey
+type warningBucket struct {
+	tokens     float64
+	last       time.Time
+	suppressed int
+}
+
+// maxWarningBuckets bounds the buckets a warningLimiter keeps. Flag keys come
+// from callers, e.g. through OFREP requests, so they aren't bounded otherwise.
+const maxWarningBuckets = 10000
+
+// warningLimiter rate-limits the warnings logged for evaluation errors with a
+// token bucket per flag and error code. It's shared with clones. Buckets idle
+// long enough to refill are the same as new ones and are dropped, and past
+// maxWarningBuckets the least recently used one is; the count of warnings a
+// dropped bucket suppressed is lost.
+type warningLimiter struct {
+	mu       sync.Mutex
+	burst    int
+	interval time.Duration
+	buckets  map[warningKey]*warningBucket
+	swept    time.Time
+}
+
+func newWarningLimiter(burst int, interval time.Duration) *warningLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &warningLimiter{burst: burst, interval: interval, buckets: make(map[warningKey]*warningBucket)}
+}
+
+// allow takes a token for key at now. It reports whether the warning may be
+// logged and, if so, how many warnings were suppressed since the last one
+// logged.
+func (l *warningLimiter) allow(key warningKey, now time.Time) (bool, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweep(now)
+	bucket, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxWarningBuckets {
+			l.dropLeastRecent()
+		}
+		bucket = &warningBucket{tokens: float64(l.burst), last: now}
+		l.buckets[key] = bucket
+	}
+	if l.interval > 0 {
+		bucket.tokens += float64(now.Sub(bucket.last)) / float64(l.interval)
+		bucket.tokens = min(bucket.tokens, float64(l.burst))
+	}
+	bucket.last = now
+	if bucket.tokens < 1 {
+		bucket.suppressed++
+		return false, 0
+	}
+	bucket.tokens--
+	suppressed := bucket.suppressed
+	bucket.suppressed = 0
+	return true, suppressed
+}
+
+// sweep drops the buckets idle for as long as they take to refill, at most
+// once per that time. Buckets never refill without an interval.
+func (l *warningLimiter) sweep(now time.Time) {
+	idle := time.Duration(l.burst) * l.interval
+	if idle <= 0 || now.Sub(l.swept) < idle {
+		return
+	}
+	l.swept = now
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.last) >= idle {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// dropLeastRecent drops the bucket used least recently
+func (l *warningLimiter) dropLeastRecent() {
+	var oldest warningKey
+	var oldestLast time.Time
+	first := true
+	for key, bucket := range l.buckets {
+		if first || bucket.last.Before(oldestLast) {
+			oldest, oldestLast, first = key, bucket.last, false
+		}
+	}
+	delete(l.buckets, oldest)
+}
+
+// warnEvaluationError logs a warning for evaluations that failed because the
+// flag is missing or has another type, within the rate limit set with
+// WithWarningRateLimit. Once warnings for a flag and error code were
+// suppressed, the next one logged reports how many.
+func (p *Provider) warnEvaluationError(flag string, detail openfeature.ProviderResolutionDetail) {
+	var message string
+	code := detail.ResolutionDetail().ErrorCode
+	switch code {
+	case openfeature.FlagNotFoundCode:
+		message = "Flag not found"
+	case openfeature.TypeMismatchCode:
+		message = "Flag value has another type than requested"
+	default:
+		return
+	}
+
+	if p.warnLimiter != nil {
+		allowed, suppressed := p.warnLimiter.allow(warningKey{flag, code}, p.now())
+		if !allowed {
+			return
+		}
+		if suppressed > 0 {
+			p.log().Warn("Suppressed similar messages", "flag", flag, "errorCode", code, "suppressed", suppressed)
+		}
+	}
+	p.log().Warn(message, "flag", flag, "error", detail.ResolutionDetail().ErrorMessage)
+}
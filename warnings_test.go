@@ -0,0 +1,90 @@
+package growthbook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/open-feature/go-sdk/openfeature"
+)
+
+func TestWarningRateLimit(t *testing.T) {
+	var logs bytes.Buffer
+	clock := newFakeClock()
+	provider := setupTestProvider(
+		WithWarningRateLimit(2, time.Minute),
+		WithClock(clock.Now),
+		WithLogger(slog.New(slog.NewTextHandler(&logs, nil))),
+	)
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+	ctx := context.Background()
+
+	for i := 0; i < 100; i++ {
+		provider.BooleanEvaluation(ctx, "missing-flag", false, nil)
+	}
+	if count := strings.Count(logs.String(), `msg="Flag not found"`); count != 2 {
+		t.Errorf("Expected the burst of 2 warnings to be logged, got %d", count)
+	}
+
+	// Warnings for other flags and error codes have buckets of their own
+	provider.IntEvaluation(ctx, "string-flag", 0, nil)
+	if !strings.Contains(logs.String(), "flag=string-flag") {
+		t.Errorf("Expected the type mismatch warning to be logged, got %q", logs.String())
+	}
+
+	// A token is back after the interval, and the suppressed warnings are counted
+	clock.Advance(time.Minute)
+	logs.Reset()
+	provider.BooleanEvaluation(ctx, "missing-flag", false, nil)
+	if !strings.Contains(logs.String(), `msg="Suppressed similar messages" flag=missing-flag errorCode=FLAG_NOT_FOUND suppressed=98`) {
+		t.Errorf("Expected a line counting the suppressed warnings, got %q", logs.String())
+	}
+	if count := strings.Count(logs.String(), `msg="Flag not found"`); count != 1 {
+		t.Errorf("Expected the warning to be logged again, got %d", count)
+	}
+}
+
+func TestWarningsUnlimitedByDefault(t *testing.T) {
+	var logs bytes.Buffer
+	provider := setupTestProvider(WithLogger(slog.New(slog.NewTextHandler(&logs, nil))))
+	_ = provider.Init(openfeature.NewEvaluationContext("", nil))
+
+	for i := 0; i < 5; i++ {
+		provider.StringEvaluation(context.Background(), "missing-flag", "", nil)
+	}
+	if count := strings.Count(logs.String(), `msg="Flag not found"`); count != 5 {
+		t.Errorf("Expected every warning to be logged, got %d", count)
+	}
+}
+
+func TestWarningLimiterDropsIdleBuckets(t *testing.T) {
+	limiter := newWarningLimiter(2, time.Minute)
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 100; i++ {
+		limiter.allow(warningKey{flag: fmt.Sprintf("flag-%d", i), code: openfeature.FlagNotFoundCode}, now)
+	}
+	limiter.allow(warningKey{flag: "active", code: openfeature.FlagNotFoundCode}, now.Add(time.Minute))
+
+	// Buckets idle for the 2 minutes they take to refill are dropped
+	limiter.allow(warningKey{flag: "active", code: openfeature.FlagNotFoundCode}, now.Add(2*time.Minute))
+	if len(limiter.buckets) != 1 {
+		t.Errorf("Expected only the active bucket to be kept, got %d", len(limiter.buckets))
+	}
+
+	// Without an interval buckets never refill, so only the cap applies
+	capped := newWarningLimiter(1, 0)
+	for i := 0; i < maxWarningBuckets+10; i++ {
+		capped.allow(warningKey{flag: fmt.Sprintf("flag-%d", i), code: openfeature.FlagNotFoundCode}, now.Add(time.Duration(i)))
+	}
+	if len(capped.buckets) != maxWarningBuckets {
+		t.Errorf("Expected the buckets to be capped at %d, got %d", maxWarningBuckets, len(capped.buckets))
+	}
+	if _, ok := capped.buckets[warningKey{flag: "flag-0", code: openfeature.FlagNotFoundCode}]; ok {
+		t.Error("Expected the least recently used bucket to be dropped")
+	}
+}